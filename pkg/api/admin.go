@@ -7,6 +7,7 @@ import (
 	"github.com/grafana/grafana/pkg/api/response"
 	"github.com/grafana/grafana/pkg/models"
 	ac "github.com/grafana/grafana/pkg/services/accesscontrol"
+	"github.com/grafana/grafana/pkg/services/login"
 	"github.com/grafana/grafana/pkg/services/user"
 	"github.com/grafana/grafana/pkg/setting"
 )
@@ -54,6 +55,27 @@ func (hs *HTTPServer) AdminGetStats(c *models.ReqContext) response.Response {
 	return response.JSON(http.StatusOK, statsQuery.Result)
 }
 
+// swagger:route POST /admin/stats/login/refresh admin adminRefreshLoginStats
+//
+// Refresh login stats.
+//
+// Recalculates the duplicate and mixed cased user metrics immediately instead of waiting for the next collection.
+// If you are running Grafana Enterprise and have Fine-grained access control enabled, you need to have a permission with action `server:stats:read`.
+//
+// Responses:
+// 200: adminRefreshLoginStatsResponse
+// 401: unauthorisedError
+// 403: forbiddenError
+// 500: internalServerError
+func (hs *HTTPServer) AdminRefreshLoginStats(c *models.ReqContext) response.Response {
+	stats, err := hs.authInfoService.GetLoginStats(c.Req.Context())
+	if err != nil {
+		return response.Error(500, "Failed to refresh login stats", err)
+	}
+
+	return response.JSON(http.StatusOK, stats)
+}
+
 func (hs *HTTPServer) getAuthorizedSettings(ctx context.Context, user *user.SignedInUser, bag setting.SettingsBag) (setting.SettingsBag, error) {
 	if hs.AccessControl.IsDisabled() {
 		return bag, nil
@@ -110,3 +132,9 @@ type GetStatsResponse struct {
 	// in:body
 	Body models.AdminStats `json:"body"`
 }
+
+// swagger:response adminRefreshLoginStatsResponse
+type RefreshLoginStatsResponse struct {
+	// in:body
+	Body login.LoginStats `json:"body"`
+}
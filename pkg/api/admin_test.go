@@ -1,14 +1,18 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/grafana/grafana/pkg/services/accesscontrol"
+	"github.com/grafana/grafana/pkg/services/login"
+	"github.com/grafana/grafana/pkg/services/login/logintest"
 	"github.com/grafana/grafana/pkg/services/sqlstore/mockstore"
 	"github.com/grafana/grafana/pkg/setting"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type getSettingsTestCase struct {
@@ -118,6 +122,28 @@ func TestAdmin_AccessControl(t *testing.T) {
 				},
 			},
 		},
+		{
+			expectedCode: http.StatusOK,
+			desc:         "AdminRefreshLoginStats should return 200 for user with correct permissions",
+			url:          "/api/admin/stats/login/refresh",
+			method:       http.MethodPost,
+			permissions: []accesscontrol.Permission{
+				{
+					Action: accesscontrol.ActionServerStatsRead,
+				},
+			},
+		},
+		{
+			expectedCode: http.StatusForbidden,
+			desc:         "AdminRefreshLoginStats should return 403 for user without required permissions",
+			url:          "/api/admin/stats/login/refresh",
+			method:       http.MethodPost,
+			permissions: []accesscontrol.Permission{
+				{
+					Action: "wrong",
+				},
+			},
+		},
 		{
 			expectedCode: http.StatusOK,
 			desc:         "AdminGetSettings should return 200 for user with correct permissions",
@@ -149,6 +175,7 @@ func TestAdmin_AccessControl(t *testing.T) {
 			sc.resp = httptest.NewRecorder()
 			hs.SettingsProvider = &setting.OSSImpl{Cfg: cfg}
 			hs.SQLStore = mockstore.NewSQLStoreMock()
+			hs.authInfoService = &logintest.AuthInfoServiceFake{}
 
 			var err error
 			sc.req, err = http.NewRequest(test.method, test.url, nil)
@@ -159,3 +186,38 @@ func TestAdmin_AccessControl(t *testing.T) {
 		})
 	}
 }
+
+func TestAPI_AdminRefreshLoginStats(t *testing.T) {
+	permissions := []accesscontrol.Permission{{Action: accesscontrol.ActionServerStatsRead}}
+
+	t.Run("should return the refreshed login stats", func(t *testing.T) {
+		sc, hs := setupAccessControlScenarioContext(t, setting.NewCfg(), "/api/admin/stats/login/refresh", permissions)
+		hs.authInfoService = &logintest.AuthInfoServiceFake{
+			ExpectedLoginStats: login.LoginStats{DuplicateUserEntries: 2, MixedCasedUsers: 3},
+		}
+
+		sc.resp = httptest.NewRecorder()
+		var err error
+		sc.req, err = http.NewRequest(http.MethodPost, "/api/admin/stats/login/refresh", nil)
+		require.NoError(t, err)
+
+		sc.exec()
+
+		assert.Equal(t, http.StatusOK, sc.resp.Code)
+		assert.JSONEq(t, `{"duplicateUserEntries":2,"mixedCasedUsers":3}`, sc.resp.Body.String())
+	})
+
+	t.Run("should return 500 if the stats can't be collected", func(t *testing.T) {
+		sc, hs := setupAccessControlScenarioContext(t, setting.NewCfg(), "/api/admin/stats/login/refresh", permissions)
+		hs.authInfoService = &logintest.AuthInfoServiceFake{ExpectedError: errors.New("database is locked")}
+
+		sc.resp = httptest.NewRecorder()
+		var err error
+		sc.req, err = http.NewRequest(http.MethodPost, "/api/admin/stats/login/refresh", nil)
+		require.NoError(t, err)
+
+		sc.exec()
+
+		assert.Equal(t, http.StatusInternalServerError, sc.resp.Code)
+	})
+}
@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/grafana/grafana/pkg/api/response"
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/models"
 	"github.com/grafana/grafana/pkg/services/ldap"
@@ -123,6 +125,20 @@ func (hs *HTTPServer) ReloadLDAPCfg(c *models.ReqContext) response.Response {
 	}
 
 	err := ldap.ReloadConfig()
+
+	evt := &events.ConfigReloaded{
+		Timestamp: time.Now(),
+		Component: "ldap",
+		UserID:    c.UserID,
+		UserLogin: c.Login,
+	}
+	if err != nil {
+		evt.Error = err.Error()
+	}
+	if pubErr := hs.bus.Publish(c.Req.Context(), evt); pubErr != nil {
+		hs.log.Error("Failed to publish config reloaded event", "component", "ldap", "error", pubErr)
+	}
+
 	if err != nil {
 		return response.Error(http.StatusInternalServerError, "Failed to reload LDAP config", err)
 	}
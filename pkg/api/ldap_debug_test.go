@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/grafana/grafana/pkg/api/response"
 	"github.com/grafana/grafana/pkg/api/routing"
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/models"
 	"github.com/grafana/grafana/pkg/services/accesscontrol"
 	"github.com/grafana/grafana/pkg/services/auth"
@@ -509,6 +511,61 @@ func TestPostSyncUserWithLDAPAPIEndpoint_WhenUserNotInLDAP(t *testing.T) {
 	assert.JSONEq(t, expected, sc.resp.Body.String())
 }
 
+// ***
+// ReloadLDAPCfg tests
+// ***
+
+func TestReloadLDAPCfg_PublishesConfigReloaded(t *testing.T) {
+	reload := func(t *testing.T, configFile string) (*httptest.ResponseRecorder, []*events.ConfigReloaded) {
+		t.Helper()
+
+		enabled := setting.LDAPEnabled
+		previousConfigFile := setting.LDAPConfigFile
+		t.Cleanup(func() {
+			setting.LDAPEnabled = enabled
+			setting.LDAPConfigFile = previousConfigFile
+		})
+		setting.LDAPEnabled = true
+		setting.LDAPConfigFile = configFile
+
+		url := "/api/admin/ldap/reload"
+		sc, hs := setupAccessControlScenarioContext(t, setting.NewCfg(), url, []accesscontrol.Permission{{Action: accesscontrol.ActionLDAPConfigReload}})
+
+		var published []*events.ConfigReloaded
+		hs.bus.AddEventListener(func(_ context.Context, evt *events.ConfigReloaded) error {
+			published = append(published, evt)
+			return nil
+		})
+
+		sc.resp = httptest.NewRecorder()
+		var err error
+		sc.req, err = http.NewRequest(http.MethodPost, url, nil)
+		require.NoError(t, err)
+		sc.exec()
+
+		return sc.resp, published
+	}
+
+	t.Run("successful reload publishes ConfigReloaded without error", func(t *testing.T) {
+		path, err := filepath.Abs("../../conf/ldap.toml")
+		require.NoError(t, err)
+
+		resp, published := reload(t, path)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Len(t, published, 1)
+		assert.Equal(t, "ldap", published[0].Component)
+		assert.Empty(t, published[0].Error)
+	})
+
+	t.Run("failed reload publishes ConfigReloaded with the error", func(t *testing.T) {
+		resp, published := reload(t, filepath.Join(t.TempDir(), "missing.toml"))
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Len(t, published, 1)
+		assert.Equal(t, "ldap", published[0].Component)
+		assert.Contains(t, published[0].Error, "Failed to load LDAP config file")
+	})
+}
+
 // ***
 // Access control tests for ldap endpoints
 // ***
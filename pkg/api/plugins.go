@@ -12,10 +12,12 @@ import (
 	"runtime"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/grafana/grafana-plugin-sdk-go/backend"
 	"github.com/grafana/grafana/pkg/api/dtos"
 	"github.com/grafana/grafana/pkg/api/response"
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/infra/fs"
 	"github.com/grafana/grafana/pkg/models"
 	"github.com/grafana/grafana/pkg/plugins"
@@ -242,6 +244,8 @@ func (hs *HTTPServer) UpdatePluginSetting(c *models.ReqContext) response.Respons
 		PluginID:                cmd.PluginId,
 		OrgID:                   cmd.OrgId,
 		EncryptedSecureJSONData: cmd.EncryptedSecureJsonData,
+		UserID:                  c.UserID,
+		UserLogin:               c.Login,
 	}); err != nil {
 		return response.Error(500, "Failed to update plugin setting", err)
 	}
@@ -413,6 +417,8 @@ func (hs *HTTPServer) InstallPlugin(c *models.ReqContext) response.Response {
 	}
 	pluginID := web.Params(c.Req)[":pluginId"]
 
+	previous, update := hs.pluginStore.Plugin(c.Req.Context(), pluginID)
+
 	err := hs.pluginInstaller.Add(c.Req.Context(), pluginID, dto.Version, plugins.CompatOpts{
 		GrafanaVersion: hs.Cfg.BuildVersion,
 		OS:             runtime.GOOS,
@@ -442,6 +448,8 @@ func (hs *HTTPServer) InstallPlugin(c *models.ReqContext) response.Response {
 		return response.Error(http.StatusInternalServerError, "Failed to install plugin", err)
 	}
 
+	hs.publishPluginInstalledOrUpdated(c, pluginID, dto.Version, previous, update)
+
 	return response.JSON(http.StatusOK, []byte{})
 }
 
@@ -465,6 +473,38 @@ func (hs *HTTPServer) UninstallPlugin(c *models.ReqContext) response.Response {
 	return response.JSON(http.StatusOK, []byte{})
 }
 
+// publishPluginInstalledOrUpdated publishes a PluginUpdated event if the plugin was already
+// installed before the request or a PluginInstalled event otherwise.
+func (hs *HTTPServer) publishPluginInstalledOrUpdated(c *models.ReqContext, pluginID, version string, previous plugins.PluginDTO, update bool) {
+	if p, exists := hs.pluginStore.Plugin(c.Req.Context(), pluginID); exists {
+		version = p.Info.Version
+	}
+
+	var evt interface{}
+	if update {
+		evt = &events.PluginUpdated{
+			Timestamp:       time.Now(),
+			PluginID:        pluginID,
+			Version:         version,
+			PreviousVersion: previous.Info.Version,
+			UserID:          c.UserID,
+			UserLogin:       c.Login,
+		}
+	} else {
+		evt = &events.PluginInstalled{
+			Timestamp: time.Now(),
+			PluginID:  pluginID,
+			Version:   version,
+			UserID:    c.UserID,
+			UserLogin: c.Login,
+		}
+	}
+
+	if err := hs.bus.Publish(c.Req.Context(), evt); err != nil {
+		hs.log.Error("Failed to publish plugin install event", "pluginId", pluginID, "error", err)
+	}
+}
+
 func translatePluginRequestErrorToAPIError(err error) response.Response {
 	if errors.Is(err, backendplugin.ErrPluginNotRegistered) {
 		return response.Error(404, "Plugin not found", err)
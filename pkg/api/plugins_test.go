@@ -18,6 +18,7 @@ import (
 	"github.com/grafana/grafana-plugin-sdk-go/backend"
 
 	"github.com/grafana/grafana/pkg/api/dtos"
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/infra/log/logtest"
 	"github.com/grafana/grafana/pkg/models"
@@ -25,7 +26,11 @@ import (
 	ac "github.com/grafana/grafana/pkg/services/accesscontrol"
 	"github.com/grafana/grafana/pkg/services/org"
 	"github.com/grafana/grafana/pkg/services/pluginsettings"
+	pluginSettings "github.com/grafana/grafana/pkg/services/pluginsettings/service"
 	"github.com/grafana/grafana/pkg/services/quota/quotatest"
+	"github.com/grafana/grafana/pkg/services/secrets/fakes"
+	secretsManager "github.com/grafana/grafana/pkg/services/secrets/manager"
+	"github.com/grafana/grafana/pkg/services/sqlstore"
 	"github.com/grafana/grafana/pkg/services/updatechecker"
 	"github.com/grafana/grafana/pkg/services/user"
 	"github.com/grafana/grafana/pkg/setting"
@@ -53,13 +58,19 @@ func Test_PluginsInstallAndUninstall(t *testing.T) {
 
 	inst := NewFakePluginInstaller()
 	for _, tc := range tcs {
+		var installed []*events.PluginInstalled
 		srv := SetupAPITestServer(t, func(hs *HTTPServer) {
 			hs.Cfg = &setting.Cfg{
 				PluginAdminEnabled:               tc.pluginAdminEnabled,
 				PluginAdminExternalManageEnabled: tc.pluginAdminExternalManageEnabled,
 			}
 			hs.pluginInstaller = inst
+			hs.pluginStore = plugins.FakePluginStore{}
 			hs.QuotaService = quotatest.NewQuotaServiceFake()
+			hs.bus.AddEventListener(func(_ context.Context, evt *events.PluginInstalled) error {
+				installed = append(installed, evt)
+				return nil
+			})
 		})
 
 		t.Run(testName("Install", tc), func(t *testing.T) {
@@ -77,6 +88,10 @@ func Test_PluginsInstallAndUninstall(t *testing.T) {
 
 			if tc.expectedHTTPStatus == 200 {
 				require.Equal(t, fakePlugin{pluginID: "test", version: "1.0.2"}, inst.plugins["test"])
+				require.Len(t, installed, 1)
+				require.Equal(t, "test", installed[0].PluginID)
+				require.Equal(t, "1.0.2", installed[0].Version)
+				require.Equal(t, int64(1), installed[0].UserID)
 			}
 		})
 
@@ -100,6 +115,111 @@ func Test_PluginsInstallAndUninstall(t *testing.T) {
 	}
 }
 
+func Test_PluginsInstall_PublishesPluginUpdated(t *testing.T) {
+	inst := NewFakePluginInstaller()
+	inst.plugins["test"] = fakePlugin{pluginID: "test", version: "1.0.1"}
+
+	var installed []*events.PluginInstalled
+	var updated []*events.PluginUpdated
+	srv := SetupAPITestServer(t, func(hs *HTTPServer) {
+		hs.Cfg = &setting.Cfg{PluginAdminEnabled: true}
+		hs.pluginInstaller = inst
+		hs.pluginStore = &fakeInstalledPluginStore{inst: inst}
+		hs.QuotaService = quotatest.NewQuotaServiceFake()
+		hs.bus.AddEventListener(func(_ context.Context, evt *events.PluginInstalled) error {
+			installed = append(installed, evt)
+			return nil
+		})
+		hs.bus.AddEventListener(func(_ context.Context, evt *events.PluginUpdated) error {
+			updated = append(updated, evt)
+			return nil
+		})
+	})
+
+	req := srv.NewPostRequest("/api/plugins/test/install", strings.NewReader("{ \"version\": \"1.0.2\" }"))
+	webtest.RequestWithSignedInUser(req, &user.SignedInUser{UserID: 1, OrgID: 1, Login: "admin", OrgRole: org.RoleAdmin, IsGrafanaAdmin: true})
+	resp, err := srv.SendJSON(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Empty(t, installed)
+	require.Len(t, updated, 1)
+	require.Equal(t, "test", updated[0].PluginID)
+	require.Equal(t, "1.0.2", updated[0].Version)
+	require.Equal(t, "1.0.1", updated[0].PreviousVersion)
+	require.Equal(t, int64(1), updated[0].UserID)
+	require.Equal(t, "admin", updated[0].UserLogin)
+}
+
+func TestIntegrationUpdatePluginSetting_PublishesPluginEnabled(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	db := sqlstore.InitTestDB(t)
+	secretsService := secretsManager.SetupTestService(t, fakes.NewFakeSecretsStore())
+
+	var enabled []*events.PluginEnabled
+	db.Bus().AddEventListener(func(_ context.Context, evt *events.PluginEnabled) error {
+		enabled = append(enabled, evt)
+		return nil
+	})
+
+	srv := SetupAPITestServer(t, func(hs *HTTPServer) {
+		hs.pluginStore = plugins.FakePluginStore{PluginList: []plugins.PluginDTO{{JSONData: plugins.JSONData{ID: "test-app"}}}}
+		hs.PluginSettings = pluginSettings.ProvideService(db, secretsService)
+		hs.QuotaService = quotatest.NewQuotaServiceFake()
+	})
+
+	update := func(t *testing.T, enable bool) {
+		t.Helper()
+		body := fmt.Sprintf(`{"enabled": %t, "version": "1.0.0"}`, enable)
+		req := srv.NewPostRequest("/api/plugins/test-app/settings", strings.NewReader(body))
+		webtest.RequestWithSignedInUser(req, &user.SignedInUser{UserID: 2, OrgID: 1, Login: "orgadmin", OrgRole: org.RoleAdmin})
+		resp, err := srv.SendJSON(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	t.Run("enabling a plugin without settings publishes PluginEnabled", func(t *testing.T) {
+		update(t, true)
+		require.Len(t, enabled, 1)
+		require.Equal(t, "test-app", enabled[0].PluginID)
+		require.Equal(t, "1.0.0", enabled[0].Version)
+		require.Equal(t, int64(1), enabled[0].OrgID)
+		require.Equal(t, int64(2), enabled[0].UserID)
+		require.Equal(t, "orgadmin", enabled[0].UserLogin)
+	})
+
+	t.Run("updating an already enabled plugin does not publish PluginEnabled", func(t *testing.T) {
+		update(t, true)
+		require.Len(t, enabled, 1)
+	})
+
+	t.Run("re-enabling a disabled plugin publishes PluginEnabled", func(t *testing.T) {
+		update(t, false)
+		require.Len(t, enabled, 1)
+		update(t, true)
+		require.Len(t, enabled, 2)
+	})
+}
+
+// fakeInstalledPluginStore reports the plugins installed through a fakePluginInstaller.
+type fakeInstalledPluginStore struct {
+	plugins.Store
+
+	inst *fakePluginInstaller
+}
+
+func (s *fakeInstalledPluginStore) Plugin(_ context.Context, pluginID string) (plugins.PluginDTO, bool) {
+	p, exists := s.inst.plugins[pluginID]
+	if !exists {
+		return plugins.PluginDTO{}, false
+	}
+	return plugins.PluginDTO{JSONData: plugins.JSONData{ID: p.pluginID, Info: plugins.Info{Version: p.version}}}, true
+}
+
 func Test_PluginsInstallAndUninstall_AccessControl(t *testing.T) {
 	canInstall := []ac.Permission{{Action: plugins.ActionInstall}}
 	cannotInstall := []ac.Permission{{Action: "plugins:cannotinstall"}}
@@ -131,6 +251,7 @@ func Test_PluginsInstallAndUninstall_AccessControl(t *testing.T) {
 		setInitCtxSignedInViewer(sc.initCtx)
 		setAccessControlPermissions(sc.acmock, tc.permissions, sc.initCtx.OrgID)
 		sc.hs.pluginInstaller = NewFakePluginInstaller()
+		sc.hs.pluginStore = plugins.FakePluginStore{}
 
 		t.Run(testName("Install", tc), func(t *testing.T) {
 			input := strings.NewReader("{ \"version\": \"1.0.2\" }")
@@ -78,3 +78,138 @@ type FolderTitleUpdated struct {
 	UID       string    `json:"uid"`
 	OrgID     int64     `json:"org_id"`
 }
+
+type TeamMemberAdded struct {
+	Timestamp  time.Time `json:"timestamp"`
+	TeamID     int64     `json:"team_id"`
+	UserID     int64     `json:"user_id"`
+	OrgID      int64     `json:"org_id"`
+	External   bool      `json:"external"`
+	Permission int       `json:"permission"`
+}
+
+type TeamMemberUpdated struct {
+	Timestamp  time.Time `json:"timestamp"`
+	TeamID     int64     `json:"team_id"`
+	UserID     int64     `json:"user_id"`
+	OrgID      int64     `json:"org_id"`
+	Permission int       `json:"permission"`
+}
+
+type TeamMemberRemoved struct {
+	Timestamp time.Time `json:"timestamp"`
+	TeamID    int64     `json:"team_id"`
+	UserID    int64     `json:"user_id"`
+	OrgID     int64     `json:"org_id"`
+}
+
+type ResourcePermissionUpdated struct {
+	Timestamp   time.Time `json:"timestamp"`
+	OrgID       int64     `json:"org_id"`
+	Resource    string    `json:"resource"`
+	ResourceID  string    `json:"resource_id"`
+	UserID      int64     `json:"user_id,omitempty"`
+	TeamID      int64     `json:"team_id,omitempty"`
+	BuiltInRole string    `json:"builtin_role,omitempty"`
+	Permission  string    `json:"permission"`
+	Actions     []string  `json:"actions"`
+}
+
+type DashboardACLUpdated struct {
+	Timestamp   time.Time          `json:"timestamp"`
+	DashboardID int64              `json:"dashboard_id"`
+	OrgID       int64              `json:"org_id"`
+	Items       []DashboardACLItem `json:"items"`
+}
+
+type DashboardACLItem struct {
+	UserID     int64  `json:"user_id,omitempty"`
+	TeamID     int64  `json:"team_id,omitempty"`
+	Role       string `json:"role,omitempty"`
+	Permission int    `json:"permission"`
+}
+
+type PluginInstalled struct {
+	Timestamp time.Time `json:"timestamp"`
+	PluginID  string    `json:"plugin_id"`
+	Version   string    `json:"version"`
+	UserID    int64     `json:"user_id"`
+	UserLogin string    `json:"user_login"`
+}
+
+type PluginUpdated struct {
+	Timestamp       time.Time `json:"timestamp"`
+	PluginID        string    `json:"plugin_id"`
+	Version         string    `json:"version"`
+	PreviousVersion string    `json:"previous_version"`
+	UserID          int64     `json:"user_id"`
+	UserLogin       string    `json:"user_login"`
+}
+
+type PluginEnabled struct {
+	Timestamp time.Time `json:"timestamp"`
+	PluginID  string    `json:"plugin_id"`
+	Version   string    `json:"version"`
+	OrgID     int64     `json:"org_id"`
+	UserID    int64     `json:"user_id"`
+	UserLogin string    `json:"user_login"`
+}
+
+type DashboardSnapshotCreated struct {
+	Timestamp time.Time `json:"timestamp"`
+	Key       string    `json:"key"`
+	Name      string    `json:"name"`
+	OrgID     int64     `json:"org_id"`
+	UserID    int64     `json:"user_id"`
+	External  bool      `json:"external"`
+	Expires   time.Time `json:"expires"`
+}
+
+type DashboardSnapshotDeleted struct {
+	Timestamp time.Time `json:"timestamp"`
+	Key       string    `json:"key"`
+	Name      string    `json:"name"`
+	OrgID     int64     `json:"org_id"`
+}
+
+type PublicDashboardCreated struct {
+	Timestamp    time.Time `json:"timestamp"`
+	UID          string    `json:"uid"`
+	DashboardUID string    `json:"dashboard_uid"`
+	OrgID        int64     `json:"org_id"`
+	IsEnabled    bool      `json:"is_enabled"`
+	UserID       int64     `json:"user_id"`
+}
+
+type PublicDashboardUpdated struct {
+	Timestamp    time.Time `json:"timestamp"`
+	UID          string    `json:"uid"`
+	DashboardUID string    `json:"dashboard_uid"`
+	OrgID        int64     `json:"org_id"`
+	IsEnabled    bool      `json:"is_enabled"`
+	UserID       int64     `json:"user_id"`
+}
+
+type ProvisioningReloaded struct {
+	Timestamp   time.Time              `json:"timestamp"`
+	Provisioner string                 `json:"provisioner"`
+	DurationMs  int64                  `json:"duration_ms"`
+	Error       string                 `json:"error,omitempty"`
+	Dashboards  *ProvisionedDashboards `json:"dashboards,omitempty"`
+	UserID      int64                  `json:"user_id"`
+	UserLogin   string                 `json:"user_login"`
+}
+
+type ProvisionedDashboards struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Deleted int `json:"deleted"`
+}
+
+type ConfigReloaded struct {
+	Timestamp time.Time `json:"timestamp"`
+	Component string    `json:"component"`
+	Error     string    `json:"error,omitempty"`
+	UserID    int64     `json:"user_id"`
+	UserLogin string    `json:"user_login"`
+}
@@ -45,6 +45,8 @@ type UpdatePluginSettingCmd struct {
 	PluginId                string            `json:"-"`
 	OrgId                   int64             `json:"-"`
 	EncryptedSecureJsonData map[string][]byte `json:"-"`
+	UserId                  int64             `json:"-"`
+	UserLogin               string            `json:"-"`
 }
 
 // specific command, will only update version
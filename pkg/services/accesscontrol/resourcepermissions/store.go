@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/models"
 	"github.com/grafana/grafana/pkg/services/accesscontrol"
 	"github.com/grafana/grafana/pkg/services/org"
@@ -69,7 +70,7 @@ func (s *store) setUserResourcePermission(
 	cmd SetResourcePermissionCommand,
 	hook UserResourceHookFunc,
 ) (*accesscontrol.ResourcePermission, error) {
-	permission, err := s.setResourcePermission(sess, orgID, accesscontrol.ManagedUserRoleName(user.ID), s.userAdder(sess, orgID, user.ID), cmd)
+	permission, changed, err := s.setResourcePermission(sess, orgID, accesscontrol.ManagedUserRoleName(user.ID), s.userAdder(sess, orgID, user.ID), cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +81,9 @@ func (s *store) setUserResourcePermission(
 		}
 	}
 
+	if changed {
+		publishPermissionUpdated(sess, orgID, cmd, events.ResourcePermissionUpdated{UserID: user.ID})
+	}
 	return permission, nil
 }
 
@@ -108,7 +112,7 @@ func (s *store) setTeamResourcePermission(
 	cmd SetResourcePermissionCommand,
 	hook TeamResourceHookFunc,
 ) (*accesscontrol.ResourcePermission, error) {
-	permission, err := s.setResourcePermission(sess, orgID, accesscontrol.ManagedTeamRoleName(teamID), s.teamAdder(sess, orgID, teamID), cmd)
+	permission, changed, err := s.setResourcePermission(sess, orgID, accesscontrol.ManagedTeamRoleName(teamID), s.teamAdder(sess, orgID, teamID), cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +123,9 @@ func (s *store) setTeamResourcePermission(
 		}
 	}
 
+	if changed {
+		publishPermissionUpdated(sess, orgID, cmd, events.ResourcePermissionUpdated{TeamID: teamID})
+	}
 	return permission, nil
 }
 
@@ -151,7 +158,7 @@ func (s *store) setBuiltInResourcePermission(
 	cmd SetResourcePermissionCommand,
 	hook BuiltinResourceHookFunc,
 ) (*accesscontrol.ResourcePermission, error) {
-	permission, err := s.setResourcePermission(sess, orgID, accesscontrol.ManagedBuiltInRoleName(builtInRole), s.builtInRoleAdder(sess, orgID, builtInRole), cmd)
+	permission, changed, err := s.setResourcePermission(sess, orgID, accesscontrol.ManagedBuiltInRoleName(builtInRole), s.builtInRoleAdder(sess, orgID, builtInRole), cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +169,9 @@ func (s *store) setBuiltInResourcePermission(
 		}
 	}
 
+	if changed {
+		publishPermissionUpdated(sess, orgID, cmd, events.ResourcePermissionUpdated{BuiltInRole: builtInRole})
+	}
 	return permission, nil
 }
 
@@ -197,14 +207,28 @@ func (s *store) SetResourcePermissions(
 	return permissions, err
 }
 
+// publishPermissionUpdated queues a ResourcePermissionUpdated event for the assignee set on evt,
+// it is only published once the surrounding transaction has been committed
+func publishPermissionUpdated(sess *sqlstore.DBSession, orgID int64, cmd SetResourcePermissionCommand, evt events.ResourcePermissionUpdated) {
+	evt.Timestamp = time.Now()
+	evt.OrgID = orgID
+	evt.Resource = cmd.Resource
+	evt.ResourceID = cmd.ResourceID
+	evt.Permission = cmd.Permission
+	evt.Actions = cmd.Actions
+	sess.PublishAfterCommit(&evt)
+}
+
 type roleAdder func(roleID int64) error
 
+// setResourcePermission sets the permission for the managed role on a resource,
+// it reports whether any permission had to be added or removed to do so
 func (s *store) setResourcePermission(
 	sess *sqlstore.DBSession, orgID int64, roleName string, adder roleAdder, cmd SetResourcePermissionCommand,
-) (*accesscontrol.ResourcePermission, error) {
+) (*accesscontrol.ResourcePermission, bool, error) {
 	role, err := s.getOrCreateManagedRole(sess, orgID, roleName, adder)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	rawSQL := `SELECT p.* FROM permission as p INNER JOIN role r on r.id = p.role_id WHERE r.id = ? AND p.scope = ?`
@@ -212,7 +236,7 @@ func (s *store) setResourcePermission(
 	var current []accesscontrol.Permission
 	scope := accesscontrol.Scope(cmd.Resource, cmd.ResourceAttribute, cmd.ResourceID)
 	if err := sess.SQL(rawSQL, role.ID, scope).Find(&current); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	missing := make(map[string]struct{}, len(cmd.Actions))
@@ -230,24 +254,25 @@ func (s *store) setResourcePermission(
 	}
 
 	if err := deletePermissions(sess, remove); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	if err := s.createPermissions(sess, role.ID, cmd.Resource, cmd.ResourceID, cmd.ResourceAttribute, missing); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	permissions, err := s.getPermissions(sess, cmd.Resource, cmd.ResourceID, cmd.ResourceAttribute, role.ID)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
+	changed := len(remove) > 0 || len(missing) > 0
 	permission := flatPermissionsToResourcePermission(scope, permissions)
 	if permission == nil {
-		return &accesscontrol.ResourcePermission{}, nil
+		return &accesscontrol.ResourcePermission{}, changed, nil
 	}
 
-	return permission, nil
+	return permission, changed, nil
 }
 
 func (s *store) GetResourcePermissions(ctx context.Context, orgID int64, query GetResourcePermissionsQuery) ([]accesscontrol.ResourcePermission, error) {
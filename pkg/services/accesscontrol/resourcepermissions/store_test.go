@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/services/accesscontrol"
 	"github.com/grafana/grafana/pkg/services/sqlstore"
 	"github.com/grafana/grafana/pkg/services/user"
@@ -345,6 +346,100 @@ type getResourcePermissionsTest struct {
 	onlyManaged       bool
 }
 
+func TestIntegrationStore_PublishesResourcePermissionUpdated(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	store, sql := setupTestEnv(t)
+
+	var published []*events.ResourcePermissionUpdated
+	sql.Bus().AddEventListener(func(_ context.Context, evt *events.ResourcePermissionUpdated) error {
+		published = append(published, evt)
+		return nil
+	})
+
+	cmd := SetResourcePermissionCommand{
+		Actions:    []string{"datasources:query"},
+		Resource:   "datasources",
+		ResourceID: "1",
+		Permission: "Query",
+	}
+
+	_, err := store.SetTeamResourcePermission(context.Background(), 1, 2, cmd, nil)
+	require.NoError(t, err)
+	require.Len(t, published, 1)
+	assert.Equal(t, int64(1), published[0].OrgID)
+	assert.Equal(t, int64(2), published[0].TeamID)
+	assert.Equal(t, "datasources", published[0].Resource)
+	assert.Equal(t, "1", published[0].ResourceID)
+	assert.Equal(t, "Query", published[0].Permission)
+	assert.Equal(t, []string{"datasources:query"}, published[0].Actions)
+
+	t.Run("should not publish when nothing changed", func(t *testing.T) {
+		_, err := store.SetTeamResourcePermission(context.Background(), 1, 2, cmd, nil)
+		require.NoError(t, err)
+		assert.Len(t, published, 1)
+	})
+
+	t.Run("should publish when permission is removed", func(t *testing.T) {
+		_, err := store.SetTeamResourcePermission(context.Background(), 1, 2, SetResourcePermissionCommand{
+			Resource:   "datasources",
+			ResourceID: "1",
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, published, 2)
+		assert.Empty(t, published[1].Actions)
+	})
+}
+
+func TestIntegrationPublishResourcePermissionsRemoved(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	store, sql := setupTestEnv(t)
+
+	cmd := SetResourcePermissionCommand{
+		Actions:           []string{"datasources:query"},
+		Resource:          "datasources",
+		ResourceID:        "1",
+		ResourceAttribute: "uid",
+		Permission:        "Query",
+	}
+	_, err := store.SetTeamResourcePermission(context.Background(), 1, 2, cmd, nil)
+	require.NoError(t, err)
+	_, err = store.SetBuiltInResourcePermission(context.Background(), 1, "Viewer", cmd, nil)
+	require.NoError(t, err)
+
+	var published []*events.ResourcePermissionUpdated
+	sql.Bus().AddEventListener(func(_ context.Context, evt *events.ResourcePermissionUpdated) error {
+		published = append(published, evt)
+		return nil
+	})
+
+	err = sql.WithTransactionalDbSession(context.Background(), func(sess *sqlstore.DBSession) error {
+		return sqlstore.PublishResourcePermissionsRemoved(sess, "datasources", "1", accesscontrol.Scope("datasources", "uid", "1"))
+	})
+	require.NoError(t, err)
+	require.Len(t, published, 2)
+	var teamIDs []int64
+	var builtInRoles []string
+	for _, evt := range published {
+		assert.Equal(t, int64(1), evt.OrgID)
+		assert.Equal(t, "datasources", evt.Resource)
+		assert.Equal(t, "1", evt.ResourceID)
+		assert.Empty(t, evt.Permission)
+		assert.Empty(t, evt.Actions)
+		if evt.TeamID != 0 {
+			teamIDs = append(teamIDs, evt.TeamID)
+		}
+		if evt.BuiltInRole != "" {
+			builtInRoles = append(builtInRoles, evt.BuiltInRole)
+		}
+	}
+	assert.Equal(t, []int64{2}, teamIDs)
+	assert.Equal(t, []string{"Viewer"}, builtInRoles)
+}
+
 func TestIntegrationStore_GetResourcePermissions(t *testing.T) {
 	tests := []getResourcePermissionsTest{
 		{
@@ -152,6 +152,10 @@ func (d *DashboardStore) HasAdminPermissionInDashboardsOrFolders(ctx context.Con
 
 func (d *DashboardStore) DeleteACLByUser(ctx context.Context, userID int64) error {
 	return d.sqlStore.WithTransactionalDbSession(ctx, func(sess *sqlstore.DBSession) error {
+		if err := sqlstore.PublishDashboardACLsRemoved(sess, 0, 0, 0, userID); err != nil {
+			return err
+		}
+
 		var rawSQL = "DELETE FROM dashboard_acl WHERE user_id = ?"
 		_, err := sess.Exec(rawSQL, userID)
 		return err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/models"
 	"github.com/grafana/grafana/pkg/services/org"
 	"github.com/grafana/grafana/pkg/services/sqlstore"
@@ -253,11 +254,111 @@ func TestIntegrationDashboardACLDataAccess(t *testing.T) {
 		require.False(t, query.Result[1].Inherited)
 	})
 
+	t.Run("Updating acl publishes the new acl entries", func(t *testing.T) {
+		setup(t)
+		var published []*events.DashboardACLUpdated
+		sqlStore.Bus().AddEventListener(func(_ context.Context, evt *events.DashboardACLUpdated) error {
+			published = append(published, evt)
+			return nil
+		})
+
+		editor := org.RoleEditor
+		err := updateDashboardACL(t, dashboardStore, savedFolder.Id, models.DashboardACL{
+			OrgID:       1,
+			UserID:      currentUser.ID,
+			DashboardID: savedFolder.Id,
+			Permission:  models.PERMISSION_EDIT,
+		}, models.DashboardACL{
+			OrgID:       1,
+			Role:        &editor,
+			DashboardID: savedFolder.Id,
+			Permission:  models.PERMISSION_VIEW,
+		})
+		require.NoError(t, err)
+		require.Len(t, published, 1)
+		require.Equal(t, savedFolder.Id, published[0].DashboardID)
+		require.Equal(t, int64(1), published[0].OrgID)
+		require.Equal(t, []events.DashboardACLItem{
+			{UserID: currentUser.ID, Permission: int(models.PERMISSION_EDIT)},
+			{Role: string(org.RoleEditor), Permission: int(models.PERMISSION_VIEW)},
+		}, published[0].Items)
+
+		err = dashboardStore.UpdateDashboardACL(context.Background(), savedFolder.Id, nil)
+		require.NoError(t, err)
+		require.Len(t, published, 2)
+		require.Equal(t, int64(1), published[1].OrgID)
+		require.Empty(t, published[1].Items)
+	})
+
 	t.Run("Delete acl by user", func(t *testing.T) {
 		setup(t)
 		err := dashboardStore.DeleteACLByUser(context.Background(), currentUser.ID)
 		require.NoError(t, err)
 	})
+
+	t.Run("Delete acl by user publishes the remaining acl entries", func(t *testing.T) {
+		setup(t)
+		editor := org.RoleEditor
+		err := updateDashboardACL(t, dashboardStore, savedFolder.Id, models.DashboardACL{
+			OrgID:       1,
+			UserID:      currentUser.ID,
+			DashboardID: savedFolder.Id,
+			Permission:  models.PERMISSION_EDIT,
+		}, models.DashboardACL{
+			OrgID:       1,
+			Role:        &editor,
+			DashboardID: savedFolder.Id,
+			Permission:  models.PERMISSION_VIEW,
+		})
+		require.NoError(t, err)
+
+		var published []*events.DashboardACLUpdated
+		sqlStore.Bus().AddEventListener(func(_ context.Context, evt *events.DashboardACLUpdated) error {
+			published = append(published, evt)
+			return nil
+		})
+
+		err = dashboardStore.DeleteACLByUser(context.Background(), currentUser.ID)
+		require.NoError(t, err)
+		require.Len(t, published, 1)
+		require.Equal(t, savedFolder.Id, published[0].DashboardID)
+		require.Equal(t, int64(1), published[0].OrgID)
+		require.Equal(t, []events.DashboardACLItem{
+			{Role: string(org.RoleEditor), Permission: int(models.PERMISSION_VIEW)},
+		}, published[0].Items)
+	})
+
+	t.Run("Deleting a folder publishes its acl and its dashboards acl as removed", func(t *testing.T) {
+		setup(t)
+		err := updateDashboardACL(t, dashboardStore, savedFolder.Id, models.DashboardACL{
+			OrgID:       1,
+			UserID:      currentUser.ID,
+			DashboardID: savedFolder.Id,
+			Permission:  models.PERMISSION_EDIT,
+		})
+		require.NoError(t, err)
+		err = updateDashboardACL(t, dashboardStore, childDash.Id, models.DashboardACL{
+			OrgID:       1,
+			UserID:      currentUser.ID,
+			DashboardID: childDash.Id,
+			Permission:  models.PERMISSION_VIEW,
+		})
+		require.NoError(t, err)
+
+		var published []*events.DashboardACLUpdated
+		sqlStore.Bus().AddEventListener(func(_ context.Context, evt *events.DashboardACLUpdated) error {
+			published = append(published, evt)
+			return nil
+		})
+
+		err = dashboardStore.DeleteDashboard(context.Background(), &models.DeleteDashboardCommand{Id: savedFolder.Id, OrgId: 1})
+		require.NoError(t, err)
+		require.Len(t, published, 2)
+		require.Equal(t, savedFolder.Id, published[0].DashboardID)
+		require.Empty(t, published[0].Items)
+		require.Equal(t, childDash.Id, published[1].DashboardID)
+		require.Empty(t, published[1].Items)
+	})
 }
 
 func createUser(t *testing.T, sqlStore *sqlstore.SQLStore, name string, role string, isAdmin bool) user.User {
@@ -8,6 +8,7 @@ import (
 
 	"xorm.io/xorm"
 
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/infra/metrics"
 	"github.com/grafana/grafana/pkg/models"
@@ -226,8 +227,37 @@ func (d *DashboardStore) UpdateDashboardACL(ctx context.Context, dashboardID int
 
 		// Update dashboard HasACL flag
 		dashboard := models.Dashboard{HasACL: true}
-		_, err = sess.Cols("has_acl").Where("id=?", dashboardID).Update(&dashboard)
-		return err
+		if _, err := sess.Cols("has_acl").Where("id=?", dashboardID).Update(&dashboard); err != nil {
+			return err
+		}
+
+		evt := &events.DashboardACLUpdated{
+			Timestamp:   time.Now(),
+			DashboardID: dashboardID,
+			Items:       make([]events.DashboardACLItem, 0, len(items)),
+		}
+		for _, item := range items {
+			evt.OrgID = item.OrgID
+			aclItem := events.DashboardACLItem{
+				UserID:     item.UserID,
+				TeamID:     item.TeamID,
+				Permission: int(item.Permission),
+			}
+			if item.Role != nil {
+				aclItem.Role = string(*item.Role)
+			}
+			evt.Items = append(evt.Items, aclItem)
+		}
+
+		// an empty ACL carries no org, fall back to the dashboard itself
+		if len(items) == 0 {
+			if _, err := sess.Table("dashboard").Cols("org_id").Where("id=?", dashboardID).Get(&evt.OrgID); err != nil {
+				return err
+			}
+		}
+
+		sess.PublishAfterCommit(evt)
+		return nil
 	})
 }
 
@@ -716,6 +746,10 @@ func (d *DashboardStore) deleteDashboard(cmd *models.DeleteDashboardCommand, ses
 		"DELETE FROM dashboard_acl WHERE dashboard_id = ?",
 	}
 
+	if err := sqlstore.PublishDashboardACLsRemoved(sess, dashboard.OrgId, dashboard.Id, 0, 0); err != nil {
+		return err
+	}
+
 	if dashboard.IsFolder {
 		deletes = append(deletes, "DELETE FROM dashboard WHERE folder_id = ?")
 
@@ -735,14 +769,26 @@ func (d *DashboardStore) deleteDashboard(cmd *models.DeleteDashboardCommand, ses
 		}
 
 		// remove all access control permission with folder scope
-		_, err = sess.Exec("DELETE FROM permission WHERE scope = ?", dashboards.ScopeFoldersProvider.GetResourceScopeUID(dashboard.Uid))
+		folderScope := dashboards.ScopeFoldersProvider.GetResourceScopeUID(dashboard.Uid)
+		if err := sqlstore.PublishResourcePermissionsRemoved(sess, "folders", dashboard.Uid, folderScope); err != nil {
+			return err
+		}
+		_, err = sess.Exec("DELETE FROM permission WHERE scope = ?", folderScope)
 		if err != nil {
 			return err
 		}
 
 		for _, dash := range dashIds {
+			if err := sqlstore.PublishDashboardACLsRemoved(sess, dashboard.OrgId, dash.Id, 0, 0); err != nil {
+				return err
+			}
+
 			// remove all access control permission with child dashboard scopes
-			_, err = sess.Exec("DELETE FROM permission WHERE scope = ?", ac.GetResourceScopeUID("dashboards", dash.Uid))
+			dashScope := ac.GetResourceScopeUID("dashboards", dash.Uid)
+			if err := sqlstore.PublishResourcePermissionsRemoved(sess, "dashboards", dash.Uid, dashScope); err != nil {
+				return err
+			}
+			_, err = sess.Exec("DELETE FROM permission WHERE scope = ?", dashScope)
 			if err != nil {
 				return err
 			}
@@ -789,7 +835,11 @@ func (d *DashboardStore) deleteDashboard(cmd *models.DeleteDashboardCommand, ses
 			}
 		}
 	} else {
-		_, err = sess.Exec("DELETE FROM permission WHERE scope = ?", ac.GetResourceScopeUID("dashboards", dashboard.Uid))
+		dashScope := ac.GetResourceScopeUID("dashboards", dashboard.Uid)
+		if err := sqlstore.PublishResourcePermissionsRemoved(sess, "dashboards", dashboard.Uid, dashScope); err != nil {
+			return err
+		}
+		_, err = sess.Exec("DELETE FROM permission WHERE scope = ?", dashScope)
 		if err != nil {
 			return err
 		}
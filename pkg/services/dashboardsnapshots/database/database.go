@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/grafana/grafana/pkg/components/simplejson"
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/services/dashboardsnapshots"
 	"github.com/grafana/grafana/pkg/services/org"
@@ -35,13 +36,28 @@ func (d *DashboardSnapshotStore) DeleteExpiredSnapshots(ctx context.Context, cmd
 			return nil
 		}
 
+		now := time.Now()
+		var expired []dashboardsnapshots.DashboardSnapshot
+		if err := sess.Cols("key", "name", "org_id").Where("expires < ?", now).Find(&expired); err != nil {
+			return err
+		}
+
 		deleteExpiredSQL := "DELETE FROM dashboard_snapshot WHERE expires < ?"
-		expiredResponse, err := sess.Exec(deleteExpiredSQL, time.Now())
+		expiredResponse, err := sess.Exec(deleteExpiredSQL, now)
 		if err != nil {
 			return err
 		}
 		cmd.DeletedRows, _ = expiredResponse.RowsAffected()
 
+		for _, snapshot := range expired {
+			sess.PublishAfterCommit(&events.DashboardSnapshotDeleted{
+				Timestamp: now,
+				Key:       snapshot.Key,
+				Name:      snapshot.Name,
+				OrgID:     snapshot.OrgId,
+			})
+		}
+
 		return nil
 	})
 }
@@ -68,18 +84,48 @@ func (d *DashboardSnapshotStore) CreateDashboardSnapshot(ctx context.Context, cm
 			Created:            time.Now(),
 			Updated:            time.Now(),
 		}
-		_, err := sess.Insert(snapshot)
+		if _, err := sess.Insert(snapshot); err != nil {
+			return err
+		}
 		cmd.Result = snapshot
 
-		return err
+		sess.PublishAfterCommit(&events.DashboardSnapshotCreated{
+			Timestamp: snapshot.Created,
+			Key:       snapshot.Key,
+			Name:      snapshot.Name,
+			OrgID:     snapshot.OrgId,
+			UserID:    snapshot.UserId,
+			External:  snapshot.External,
+			Expires:   snapshot.Expires,
+		})
+
+		return nil
 	})
 }
 
 func (d *DashboardSnapshotStore) DeleteDashboardSnapshot(ctx context.Context, cmd *dashboardsnapshots.DeleteDashboardSnapshotCommand) error {
 	return d.store.WithTransactionalDbSession(ctx, func(sess *sqlstore.DBSession) error {
+		snapshot := dashboardsnapshots.DashboardSnapshot{DeleteKey: cmd.DeleteKey}
+		has, err := sess.Cols("key", "name", "org_id").Get(&snapshot)
+		if err != nil {
+			return err
+		}
+
 		var rawSQL = "DELETE FROM dashboard_snapshot WHERE delete_key=?"
-		_, err := sess.Exec(rawSQL, cmd.DeleteKey)
-		return err
+		if _, err := sess.Exec(rawSQL, cmd.DeleteKey); err != nil {
+			return err
+		}
+
+		if has {
+			sess.PublishAfterCommit(&events.DashboardSnapshotDeleted{
+				Timestamp: time.Now(),
+				Key:       snapshot.Key,
+				Name:      snapshot.Name,
+				OrgID:     snapshot.OrgId,
+			})
+		}
+
+		return nil
 	})
 }
 
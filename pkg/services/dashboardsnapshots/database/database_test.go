@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/grafana/grafana/pkg/components/simplejson"
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/services/dashboardsnapshots"
 	"github.com/grafana/grafana/pkg/services/org"
 	"github.com/grafana/grafana/pkg/services/secrets"
@@ -149,6 +150,50 @@ func TestIntegrationDashboardSnapshotDBAccess(t *testing.T) {
 	})
 }
 
+func TestIntegrationDashboardSnapshotEvents(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	sqlstore := sqlstore.InitTestDB(t)
+	dashStore := ProvideStore(sqlstore)
+
+	var created []*events.DashboardSnapshotCreated
+	var deleted []*events.DashboardSnapshotDeleted
+	sqlstore.Bus().AddEventListener(func(ctx context.Context, e *events.DashboardSnapshotCreated) error {
+		created = append(created, e)
+		return nil
+	})
+	sqlstore.Bus().AddEventListener(func(ctx context.Context, e *events.DashboardSnapshotDeleted) error {
+		deleted = append(deleted, e)
+		return nil
+	})
+
+	cmd := dashboardsnapshots.CreateDashboardSnapshotCommand{
+		Key:       "eventkey",
+		DeleteKey: "eventdeletekey",
+		Name:      "event snapshot",
+		UserId:    1000,
+		OrgId:     1,
+		Expires:   3600,
+	}
+	err := dashStore.CreateDashboardSnapshot(context.Background(), &cmd)
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	assert.Equal(t, "eventkey", created[0].Key)
+	assert.Equal(t, int64(1000), created[0].UserID)
+	assert.Equal(t, cmd.Result.Expires, created[0].Expires)
+
+	err = dashStore.DeleteDashboardSnapshot(context.Background(), &dashboardsnapshots.DeleteDashboardSnapshotCommand{DeleteKey: "eventdeletekey"})
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "eventkey", deleted[0].Key)
+	assert.Equal(t, int64(1), deleted[0].OrgID)
+
+	err = dashStore.DeleteDashboardSnapshot(context.Background(), &dashboardsnapshots.DeleteDashboardSnapshotCommand{DeleteKey: "eventdeletekey"})
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+}
+
 func TestIntegrationDeleteExpiredSnapshots(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -159,6 +204,12 @@ func TestIntegrationDeleteExpiredSnapshots(t *testing.T) {
 	t.Run("Testing dashboard snapshots clean up", func(t *testing.T) {
 		setting.SnapShotRemoveExpired = true
 
+		var deleted []string
+		sqlstore.Bus().AddEventListener(func(ctx context.Context, e *events.DashboardSnapshotDeleted) error {
+			deleted = append(deleted, e.Key)
+			return nil
+		})
+
 		nonExpiredSnapshot := createTestSnapshot(t, dashStore, "key1", 48000)
 		createTestSnapshot(t, dashStore, "key2", -1200)
 		createTestSnapshot(t, dashStore, "key3", -1200)
@@ -175,6 +226,7 @@ func TestIntegrationDeleteExpiredSnapshots(t *testing.T) {
 
 		assert.Len(t, query.Result, 1)
 		assert.Equal(t, nonExpiredSnapshot.Key, query.Result[0].Key)
+		assert.ElementsMatch(t, []string{"key2", "key3"}, deleted)
 
 		err = dashStore.DeleteExpiredSnapshots(context.Background(), &dashboardsnapshots.DeleteExpiredSnapshotsCommand{})
 		require.NoError(t, err)
@@ -188,6 +240,7 @@ func TestIntegrationDeleteExpiredSnapshots(t *testing.T) {
 
 		require.Len(t, query.Result, 1)
 		require.Equal(t, nonExpiredSnapshot.Key, query.Result[0].Key)
+		require.Len(t, deleted, 2)
 	})
 }
 
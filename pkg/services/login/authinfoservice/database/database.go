@@ -11,6 +11,7 @@ import (
 	"github.com/grafana/grafana/pkg/services/secrets"
 	"github.com/grafana/grafana/pkg/services/sqlstore"
 	"github.com/grafana/grafana/pkg/services/user"
+	"github.com/grafana/grafana/pkg/setting"
 )
 
 var GetTime = time.Now
@@ -20,14 +21,16 @@ type AuthInfoStore struct {
 	secretsService secrets.Service
 	logger         log.Logger
 	userService    user.Service
+	cfg            *setting.Cfg
 }
 
-func ProvideAuthInfoStore(sqlStore sqlstore.Store, secretsService secrets.Service, userService user.Service) login.Store {
+func ProvideAuthInfoStore(sqlStore sqlstore.Store, secretsService secrets.Service, userService user.Service, cfg *setting.Cfg) login.Store {
 	store := &AuthInfoStore{
 		sqlStore:       sqlStore,
 		secretsService: secretsService,
 		logger:         log.New("login.authinfo.store"),
 		userService:    userService,
+		cfg:            cfg,
 	}
 	InitMetrics()
 	return store
@@ -42,7 +42,7 @@ func (s *AuthInfoStore) RunMetricsCollection(ctx context.Context) error {
 	if _, err := s.GetLoginStats(ctx); err != nil {
 		s.logger.Warn("Failed to get authinfo metrics", "error", err.Error())
 	}
-	updateStatsTicker := time.NewTicker(login.MetricsCollectionInterval)
+	updateStatsTicker := time.NewTicker(s.metricsCollectionInterval())
 	defer updateStatsTicker.Stop()
 
 	for {
@@ -57,6 +57,15 @@ func (s *AuthInfoStore) RunMetricsCollection(ctx context.Context) error {
 	}
 }
 
+// metricsCollectionInterval returns the configured login stats collection interval,
+// falling back to login.MetricsCollectionInterval if none is set.
+func (s *AuthInfoStore) metricsCollectionInterval() time.Duration {
+	if s.cfg == nil || s.cfg.LoginStatsCollectionInterval <= 0 {
+		return login.MetricsCollectionInterval
+	}
+	return s.cfg.LoginStatsCollectionInterval
+}
+
 func (s *AuthInfoStore) GetLoginStats(ctx context.Context) (login.LoginStats, error) {
 	var stats login.LoginStats
 	outerErr := s.sqlStore.WithDbSession(ctx, func(dbSession *sqlstore.DBSession) error {
@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana/pkg/services/login"
+	"github.com/grafana/grafana/pkg/services/sqlstore"
+	"github.com/grafana/grafana/pkg/services/user"
+	"github.com/grafana/grafana/pkg/setting"
+)
+
+func TestAuthInfoStore_metricsCollectionInterval(t *testing.T) {
+	t.Run("uses the default interval without a config", func(t *testing.T) {
+		store := &AuthInfoStore{}
+		assert.Equal(t, login.MetricsCollectionInterval, store.metricsCollectionInterval())
+	})
+
+	t.Run("uses the default interval if the configured one is not positive", func(t *testing.T) {
+		store := &AuthInfoStore{cfg: &setting.Cfg{LoginStatsCollectionInterval: 0}}
+		assert.Equal(t, login.MetricsCollectionInterval, store.metricsCollectionInterval())
+	})
+
+	t.Run("uses the configured interval", func(t *testing.T) {
+		store := &AuthInfoStore{cfg: &setting.Cfg{LoginStatsCollectionInterval: time.Minute}}
+		assert.Equal(t, time.Minute, store.metricsCollectionInterval())
+	})
+}
+
+func TestIntegrationAuthInfoStore_RunMetricsCollection(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	sqlStore := sqlstore.InitTestDB(t)
+	cfg := setting.NewCfg()
+	cfg.LoginStatsCollectionInterval = 10 * time.Millisecond
+	store := ProvideAuthInfoStore(sqlStore, nil, nil, cfg)
+
+	login.MStatMixedCasedUsers.Set(-1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- store.RunMetricsCollection(ctx) }()
+
+	// wait for the collection that runs on start up before adding a user, so the
+	// user can only be picked up by a collection triggered by the configured interval.
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(login.MStatMixedCasedUsers) == 0
+	}, time.Second, 5*time.Millisecond)
+
+	_, err := sqlStore.CreateUser(context.Background(), user.CreateUserCommand{
+		Login: "MixedCase",
+		Email: "mixedcase@example.org",
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(login.MStatMixedCasedUsers) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
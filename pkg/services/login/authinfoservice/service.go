@@ -197,6 +197,11 @@ func (s *Implementation) GetExternalUserInfoByLogin(ctx context.Context, query *
 	return s.authInfoStore.GetExternalUserInfoByLogin(ctx, query)
 }
 
+// GetLoginStats refreshes the login stats metrics and returns the stats.
+func (s *Implementation) GetLoginStats(ctx context.Context) (login.LoginStats, error) {
+	return s.authInfoStore.GetLoginStats(ctx)
+}
+
 func (s *Implementation) Run(ctx context.Context) error {
 	s.logger.Debug("Started AuthInfo Metrics collection service")
 	return s.authInfoStore.RunMetricsCollection(ctx)
@@ -25,6 +25,7 @@ type AuthInfoServiceFake struct {
 	LatestUserID         int64
 	ExpectedUser         *user.User
 	ExpectedExternalUser *models.ExternalUserInfo
+	ExpectedLoginStats   login.LoginStats
 	ExpectedError        error
 }
 
@@ -50,6 +51,10 @@ func (a *AuthInfoServiceFake) UpdateAuthInfo(ctx context.Context, cmd *models.Up
 	return a.ExpectedError
 }
 
+func (a *AuthInfoServiceFake) GetLoginStats(ctx context.Context) (login.LoginStats, error) {
+	return a.ExpectedLoginStats, a.ExpectedError
+}
+
 func (a *AuthInfoServiceFake) GetExternalUserInfoByLogin(ctx context.Context, query *models.GetExternalUserInfoByLoginQuery) error {
 	query.Result = a.ExpectedExternalUser
 	return a.ExpectedError
@@ -8,12 +8,13 @@ import (
 )
 
 type LoginStats struct {
-	DuplicateUserEntries int `xorm:"duplicate_user_entries"`
-	MixedCasedUsers      int `xorm:"mixed_cased_users"`
+	DuplicateUserEntries int `xorm:"duplicate_user_entries" json:"duplicateUserEntries"`
+	MixedCasedUsers      int `xorm:"mixed_cased_users" json:"mixedCasedUsers"`
 }
 
 const (
-	ExporterName              = "grafana"
+	ExporterName = "grafana"
+	// MetricsCollectionInterval is used when no login stats collection interval is configured.
 	MetricsCollectionInterval = time.Hour * 4 // every 4 hours, indication of duplicate users
 )
 
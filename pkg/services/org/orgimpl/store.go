@@ -650,6 +650,14 @@ func (ss *sqlStore) RemoveOrgUser(ctx context.Context, cmd *org.RemoveOrgUserCom
 			return user.ErrUserNotFound
 		}
 
+		if err := sqlstore.PublishTeamMembersRemoved(sess, cmd.OrgID, 0, cmd.UserID); err != nil {
+			return err
+		}
+
+		if err := sqlstore.PublishDashboardACLsRemoved(sess, cmd.OrgID, 0, 0, cmd.UserID); err != nil {
+			return err
+		}
+
 		deletes := []string{
 			"DELETE FROM org_user WHERE org_id=? and user_id=?",
 			"DELETE FROM dashboard_acl WHERE org_id=? and user_id = ?",
@@ -725,6 +733,12 @@ func (ss *sqlStore) deleteUserInTransaction(sess *sqlstore.DBSession, cmd *model
 	if !has {
 		return user.ErrUserNotFound
 	}
+	if err := sqlstore.PublishTeamMembersRemoved(sess, 0, 0, cmd.UserId); err != nil {
+		return err
+	}
+	if err := sqlstore.PublishDashboardACLsRemoved(sess, 0, 0, 0, cmd.UserId); err != nil {
+		return err
+	}
 	for _, sql := range ss.userDeletions() {
 		_, err := sess.Exec(sql, cmd.UserId)
 		if err != nil {
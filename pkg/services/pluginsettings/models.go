@@ -33,6 +33,9 @@ type UpdateArgs struct {
 	PluginID                string
 	OrgID                   int64
 	EncryptedSecureJSONData map[string][]byte
+	// UserID and UserLogin identify who made the change, if anyone.
+	UserID    int64
+	UserLogin string
 }
 
 type UpdatePluginVersionArgs struct {
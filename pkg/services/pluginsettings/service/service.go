@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/models"
 	"github.com/grafana/grafana/pkg/services/pluginsettings"
@@ -103,6 +104,8 @@ func (s *Service) UpdatePluginSetting(ctx context.Context, args *pluginsettings.
 		PluginId:                args.PluginID,
 		OrgId:                   args.OrgID,
 		EncryptedSecureJsonData: encryptedSecureJsonData,
+		UserId:                  args.UserID,
+		UserLogin:               args.UserLogin,
 	})
 }
 
@@ -199,6 +202,9 @@ func (s *Service) updatePluginSetting(ctx context.Context, cmd *models.UpdatePlu
 				OrgId:    cmd.OrgId,
 				Enabled:  cmd.Enabled,
 			})
+			if cmd.Enabled {
+				publishPluginEnabled(sess, cmd)
+			}
 
 			_, err = sess.Insert(&pluginSetting)
 			return err
@@ -215,6 +221,9 @@ func (s *Service) updatePluginSetting(ctx context.Context, cmd *models.UpdatePlu
 				OrgId:    cmd.OrgId,
 				Enabled:  cmd.Enabled,
 			})
+			if cmd.Enabled {
+				publishPluginEnabled(sess, cmd)
+			}
 		}
 
 		pluginSetting.Updated = time.Now()
@@ -228,6 +237,18 @@ func (s *Service) updatePluginSetting(ctx context.Context, cmd *models.UpdatePlu
 	})
 }
 
+// publishPluginEnabled queues a PluginEnabled event for the plugin setting being saved by cmd.
+func publishPluginEnabled(sess *sqlstore.DBSession, cmd *models.UpdatePluginSettingCmd) {
+	sess.PublishAfterCommit(&events.PluginEnabled{
+		Timestamp: time.Now(),
+		PluginID:  cmd.PluginId,
+		Version:   cmd.PluginVersion,
+		OrgID:     cmd.OrgId,
+		UserID:    cmd.UserId,
+		UserLogin: cmd.UserLogin,
+	})
+}
+
 func (s *Service) updatePluginSettingVersion(ctx context.Context, cmd *models.UpdatePluginSettingVersionCmd) error {
 	return s.db.WithTransactionalDbSession(ctx, func(sess *sqlstore.DBSession) error {
 		_, err := sess.Exec("UPDATE plugin_setting SET plugin_version=? WHERE org_id=? AND plugin_id=?", cmd.PluginVersion, cmd.OrgId, cmd.PluginId)
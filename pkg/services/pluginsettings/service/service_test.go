@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/models"
 	"github.com/grafana/grafana/pkg/services/pluginsettings"
 	"github.com/grafana/grafana/pkg/services/secrets"
@@ -166,12 +167,17 @@ func TestIntegrationPluginSettings(t *testing.T) {
 			require.Equal(t, existing.SecureJsonData, ps.SecureJSONData)
 		})
 
-		t.Run("UpdatePluginSetting should update existing plugin settings and publish PluginStateChangedEvent", func(t *testing.T) {
+		t.Run("UpdatePluginSetting should update existing plugin settings and publish PluginStateChangedEvent and PluginEnabled", func(t *testing.T) {
 			var pluginStateChangedEvent *models.PluginStateChangedEvent
 			db.Bus().AddEventListener(func(_ context.Context, evt *models.PluginStateChangedEvent) error {
 				pluginStateChangedEvent = evt
 				return nil
 			})
+			var pluginEnabledEvent *events.PluginEnabled
+			db.Bus().AddEventListener(func(_ context.Context, evt *events.PluginEnabled) error {
+				pluginEnabledEvent = evt
+				return nil
+			})
 
 			cmd := &pluginsettings.UpdateArgs{
 				OrgID:         existing.OrgId,
@@ -194,6 +200,10 @@ func TestIntegrationPluginSettings(t *testing.T) {
 			require.Equal(t, existing.OrgId, pluginStateChangedEvent.OrgId)
 			require.Equal(t, existing.PluginId, pluginStateChangedEvent.PluginId)
 			require.True(t, pluginStateChangedEvent.Enabled)
+			require.NotNil(t, pluginEnabledEvent)
+			require.Equal(t, existing.OrgId, pluginEnabledEvent.OrgID)
+			require.Equal(t, existing.PluginId, pluginEnabledEvent.PluginID)
+			require.Equal(t, cmd.PluginVersion, pluginEnabledEvent.Version)
 
 			err = psService.UpdatePluginSettingPluginVersion(context.Background(), &pluginsettings.UpdatePluginVersionArgs{
 				OrgID:         cmd.OrgID,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/models"
@@ -15,8 +16,8 @@ import (
 // Grafana's database.
 type DashboardProvisioner interface {
 	HasDashboardSources() bool
-	Provision(ctx context.Context) error
-	PollChanges(ctx context.Context)
+	Provision(ctx context.Context) (Changes, error)
+	PollChanges(ctx context.Context, onChanges ChangesHandler)
 	GetProvisionerResolvedPath(name string) string
 	GetAllowUIUpdatesFromConfig(name string) bool
 	CleanUpOrphanedDashboards(ctx context.Context)
@@ -25,6 +26,36 @@ type DashboardProvisioner interface {
 // DashboardProvisionerFactory creates DashboardProvisioners based on input
 type DashboardProvisionerFactory func(context.Context, string, dashboards.DashboardProvisioningService, utils.OrgStore, utils.DashboardStore) (DashboardProvisioner, error)
 
+// Changes counts the dashboards a provisioning run created, updated or deleted.
+type Changes struct {
+	Created int
+	Updated int
+	Deleted int
+}
+
+func (c *Changes) add(other Changes) {
+	c.Created += other.Created
+	c.Updated += other.Updated
+	c.Deleted += other.Deleted
+}
+
+func (c *Changes) track(pm provisioningMetadata) {
+	if pm.created {
+		c.Created++
+	}
+	if pm.updated {
+		c.Updated++
+	}
+}
+
+func (c Changes) empty() bool {
+	return c == Changes{}
+}
+
+// ChangesHandler is called with the changes a poll for dashboard changes applied to the database
+// and the time the poll started.
+type ChangesHandler func(changes Changes, start time.Time)
+
 // Provisioner is responsible for syncing dashboard from disk to Grafana's database.
 type Provisioner struct {
 	log                log.Logger
@@ -65,21 +96,25 @@ func New(ctx context.Context, configDirectory string, provisioner dashboards.Das
 
 // Provision scans the disk for dashboards and updates
 // the database with the latest versions of those dashboards.
-func (provider *Provisioner) Provision(ctx context.Context) error {
+// It returns the changes applied to the database.
+func (provider *Provisioner) Provision(ctx context.Context) (Changes, error) {
+	var changes Changes
 	for _, reader := range provider.fileReaders {
-		if err := reader.walkDisk(ctx); err != nil {
+		readerChanges, err := reader.walkDisk(ctx)
+		changes.add(readerChanges)
+		if err != nil {
 			if os.IsNotExist(err) {
 				// don't stop the provisioning service in case the folder is missing. The folder can appear after the startup
 				provider.log.Warn("Failed to provision config", "name", reader.Cfg.Name, "error", err)
-				return nil
+				return changes, nil
 			}
 
-			return fmt.Errorf("failed to provision config %v: %w", reader.Cfg.Name, err)
+			return changes, fmt.Errorf("failed to provision config %v: %w", reader.Cfg.Name, err)
 		}
 	}
 
 	provider.duplicateValidator.validate()
-	return nil
+	return changes, nil
 }
 
 // CleanUpOrphanedDashboards deletes provisioned dashboards missing a linked reader.
@@ -96,10 +131,10 @@ func (provider *Provisioner) CleanUpOrphanedDashboards(ctx context.Context) {
 }
 
 // PollChanges starts polling for changes in dashboard definition files. It creates a goroutine for each provider
-// defined in the config.
-func (provider *Provisioner) PollChanges(ctx context.Context) {
+// defined in the config. onChanges is called after every poll that changed dashboards in the database.
+func (provider *Provisioner) PollChanges(ctx context.Context, onChanges ChangesHandler) {
 	for _, reader := range provider.fileReaders {
-		go reader.pollChanges(ctx)
+		go reader.pollChanges(ctx, onChanges)
 	}
 
 	go provider.duplicateValidator.Run(ctx)
@@ -13,8 +13,8 @@ type calls struct {
 // ProvisionerMock is a mock implementation of `Provisioner`
 type ProvisionerMock struct {
 	Calls                           *calls
-	ProvisionFunc                   func(ctx context.Context) error
-	PollChangesFunc                 func(ctx context.Context)
+	ProvisionFunc                   func(ctx context.Context) (Changes, error)
+	PollChangesFunc                 func(ctx context.Context, onChanges ChangesHandler)
 	GetProvisionerResolvedPathFunc  func(name string) string
 	GetAllowUIUpdatesFromConfigFunc func(name string) bool
 }
@@ -31,19 +31,19 @@ func (dpm *ProvisionerMock) HasDashboardSources() bool {
 }
 
 // Provision is a mock implementation of `Provisioner.Provision`
-func (dpm *ProvisionerMock) Provision(ctx context.Context) error {
+func (dpm *ProvisionerMock) Provision(ctx context.Context) (Changes, error) {
 	dpm.Calls.Provision = append(dpm.Calls.Provision, nil)
 	if dpm.ProvisionFunc != nil {
 		return dpm.ProvisionFunc(ctx)
 	}
-	return nil
+	return Changes{}, nil
 }
 
 // PollChanges is a mock implementation of `Provisioner.PollChanges`
-func (dpm *ProvisionerMock) PollChanges(ctx context.Context) {
+func (dpm *ProvisionerMock) PollChanges(ctx context.Context, onChanges ChangesHandler) {
 	dpm.Calls.PollChanges = append(dpm.Calls.PollChanges, ctx)
 	if dpm.PollChangesFunc != nil {
-		dpm.PollChangesFunc(ctx)
+		dpm.PollChangesFunc(ctx, onChanges)
 	}
 }
 
@@ -70,15 +70,21 @@ func NewDashboardFileReader(cfg *config, log log.Logger, service dashboards.Dash
 	}, nil
 }
 
-// pollChanges periodically runs walkDisk based on interval specified in the config.
-func (fr *FileReader) pollChanges(ctx context.Context) {
+// pollChanges periodically runs walkDisk based on interval specified in the config
+// and reports the changes of every run that changed dashboards to onChanges.
+func (fr *FileReader) pollChanges(ctx context.Context, onChanges ChangesHandler) {
 	ticker := time.NewTicker(time.Duration(int64(time.Second) * fr.Cfg.UpdateIntervalSeconds))
 	for {
 		select {
 		case <-ticker.C:
-			if err := fr.walkDisk(ctx); err != nil {
+			start := time.Now()
+			changes, err := fr.walkDisk(ctx)
+			if err != nil {
 				fr.log.Error("failed to search for dashboards", "error", err)
 			}
+			if !changes.empty() {
+				onChanges(changes, start)
+			}
 		case <-ctx.Done():
 			return
 		}
@@ -86,42 +92,43 @@ func (fr *FileReader) pollChanges(ctx context.Context) {
 }
 
 // walkDisk traverses the file system for the defined path, reading dashboard definition files,
-// and applies any change to the database.
-func (fr *FileReader) walkDisk(ctx context.Context) error {
+// and applies any change to the database. It returns the changes it applied, even if it failed part way.
+func (fr *FileReader) walkDisk(ctx context.Context) (Changes, error) {
+	var changes Changes
 	fr.log.Debug("Start walking disk", "path", fr.Path)
 	resolvedPath := fr.resolvedPath()
 	if _, err := os.Stat(resolvedPath); err != nil {
-		return err
+		return changes, err
 	}
 
 	provisionedDashboardRefs, err := getProvisionedDashboardsByPath(ctx, fr.dashboardProvisioningService, fr.Cfg.Name)
 	if err != nil {
-		return err
+		return changes, err
 	}
 
 	// Find relevant files
 	filesFoundOnDisk := map[string]os.FileInfo{}
 	if err := filepath.Walk(resolvedPath, createWalkFn(filesFoundOnDisk)); err != nil {
-		return err
+		return changes, err
 	}
 
-	fr.handleMissingDashboardFiles(ctx, provisionedDashboardRefs, filesFoundOnDisk)
+	changes.Deleted = fr.handleMissingDashboardFiles(ctx, provisionedDashboardRefs, filesFoundOnDisk)
 
 	usageTracker := newUsageTracker()
 	if fr.FoldersFromFilesStructure {
-		err = fr.storeDashboardsInFoldersFromFileStructure(ctx, filesFoundOnDisk, provisionedDashboardRefs, resolvedPath, usageTracker)
+		err = fr.storeDashboardsInFoldersFromFileStructure(ctx, filesFoundOnDisk, provisionedDashboardRefs, resolvedPath, usageTracker, &changes)
 	} else {
-		err = fr.storeDashboardsInFolder(ctx, filesFoundOnDisk, provisionedDashboardRefs, usageTracker)
+		err = fr.storeDashboardsInFolder(ctx, filesFoundOnDisk, provisionedDashboardRefs, usageTracker, &changes)
 	}
 	if err != nil {
-		return err
+		return changes, err
 	}
 
 	fr.mux.Lock()
 	defer fr.mux.Unlock()
 
 	fr.usageTracker = usageTracker
-	return nil
+	return changes, nil
 }
 
 func (fr *FileReader) changeWritePermissions(restrict bool) {
@@ -140,7 +147,7 @@ func (fr *FileReader) isDatabaseAccessRestricted() bool {
 
 // storeDashboardsInFolder saves dashboards from the filesystem on disk to the folder from config
 func (fr *FileReader) storeDashboardsInFolder(ctx context.Context, filesFoundOnDisk map[string]os.FileInfo,
-	dashboardRefs map[string]*models.DashboardProvisioning, usageTracker *usageTracker) error {
+	dashboardRefs map[string]*models.DashboardProvisioning, usageTracker *usageTracker, changes *Changes) error {
 	folderID, err := fr.getOrCreateFolderID(ctx, fr.Cfg, fr.dashboardProvisioningService, fr.Cfg.Folder)
 	if err != nil && !errors.Is(err, ErrFolderNameMissing) {
 		return err
@@ -155,6 +162,7 @@ func (fr *FileReader) storeDashboardsInFolder(ctx context.Context, filesFoundOnD
 		}
 
 		usageTracker.track(provisioningMetadata)
+		changes.track(provisioningMetadata)
 	}
 	return nil
 }
@@ -162,7 +170,7 @@ func (fr *FileReader) storeDashboardsInFolder(ctx context.Context, filesFoundOnD
 // storeDashboardsInFoldersFromFilesystemStructure saves dashboards from the filesystem on disk to the same folder
 // in Grafana as they are in on the filesystem.
 func (fr *FileReader) storeDashboardsInFoldersFromFileStructure(ctx context.Context, filesFoundOnDisk map[string]os.FileInfo,
-	dashboardRefs map[string]*models.DashboardProvisioning, resolvedPath string, usageTracker *usageTracker) error {
+	dashboardRefs map[string]*models.DashboardProvisioning, resolvedPath string, usageTracker *usageTracker, changes *Changes) error {
 	for path, fileInfo := range filesFoundOnDisk {
 		folderName := ""
 
@@ -178,6 +186,7 @@ func (fr *FileReader) storeDashboardsInFoldersFromFileStructure(ctx context.Cont
 
 		provisioningMetadata, err := fr.saveDashboard(ctx, path, folderID, fileInfo, dashboardRefs)
 		usageTracker.track(provisioningMetadata)
+		changes.track(provisioningMetadata)
 		if err != nil {
 			fr.log.Error("failed to save dashboard", "file", path, "error", err)
 		}
@@ -186,8 +195,9 @@ func (fr *FileReader) storeDashboardsInFoldersFromFileStructure(ctx context.Cont
 }
 
 // handleMissingDashboardFiles will unprovision or delete dashboards which are missing on disk.
+// It returns the number of deleted dashboards.
 func (fr *FileReader) handleMissingDashboardFiles(ctx context.Context, provisionedDashboardRefs map[string]*models.DashboardProvisioning,
-	filesFoundOnDisk map[string]os.FileInfo) {
+	filesFoundOnDisk map[string]os.FileInfo) int {
 	// find dashboards to delete since json file is missing
 	var dashboardsToDelete []int64
 	for path, provisioningData := range provisionedDashboardRefs {
@@ -197,6 +207,7 @@ func (fr *FileReader) handleMissingDashboardFiles(ctx context.Context, provision
 		}
 	}
 
+	deleted := 0
 	if fr.Cfg.DisableDeletion {
 		// If deletion is disabled for the provisioner we just remove provisioning metadata about the dashboard
 		// so afterwards the dashboard is considered unprovisioned.
@@ -214,9 +225,12 @@ func (fr *FileReader) handleMissingDashboardFiles(ctx context.Context, provision
 			err := fr.dashboardProvisioningService.DeleteProvisionedDashboard(ctx, dashboardID, fr.Cfg.OrgID)
 			if err != nil {
 				fr.log.Error("failed to delete dashboard", "id", dashboardID, "error", err)
+				continue
 			}
+			deleted++
 		}
 	}
+	return deleted
 }
 
 // saveDashboard saves or updates the dashboard provisioning file at path.
@@ -271,6 +285,8 @@ func (fr *FileReader) saveDashboard(ctx context.Context, path string, folderID i
 		if err != nil {
 			return provisioningMetadata, err
 		}
+		provisioningMetadata.created = !alreadyProvisioned
+		provisioningMetadata.updated = alreadyProvisioned
 	} else {
 		fr.log.Warn("Not saving new dashboard due to restricted database access", "provisioner", fr.Cfg.Name,
 			"file", path, "folderId", dash.Dashboard.FolderId)
@@ -456,6 +472,8 @@ func (fr *FileReader) getUsageTracker() *usageTracker {
 type provisioningMetadata struct {
 	uid      string
 	identity dashboardIdentity
+	created  bool
+	updated  bool
 }
 
 type dashboardIdentity struct {
@@ -121,8 +121,9 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			changes, err := reader.walkDisk(context.Background())
 			require.NoError(t, err)
+			assert.Equal(t, Changes{Created: 2}, changes)
 		})
 
 		t.Run("Can read default dashboard and replace old version in database", func(t *testing.T) {
@@ -141,7 +142,7 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			_, err = reader.walkDisk(context.Background())
 			require.NoError(t, err)
 
 			assert.Equal(t, inserted, 1)
@@ -178,7 +179,7 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			_, err = reader.walkDisk(context.Background())
 			require.NoError(t, err)
 		})
 
@@ -206,7 +207,7 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			_, err = reader.walkDisk(context.Background())
 			require.NoError(t, err)
 		})
 
@@ -241,7 +242,7 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			_, err = reader.walkDisk(context.Background())
 			require.NoError(t, err)
 		})
 
@@ -269,7 +270,7 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			_, err = reader.walkDisk(context.Background())
 			require.NoError(t, err)
 		})
 
@@ -284,7 +285,7 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			_, err = reader.walkDisk(context.Background())
 			require.NoError(t, err)
 		})
 
@@ -301,7 +302,7 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			_, err = reader.walkDisk(context.Background())
 			require.NoError(t, err)
 		})
 
@@ -339,14 +340,14 @@ func TestDashboardFileReader(t *testing.T) {
 			reader1.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader1.walkDisk(context.Background())
+			_, err = reader1.walkDisk(context.Background())
 			require.NoError(t, err)
 
 			reader2, err := NewDashboardFileReader(cfg2, logger, nil, fakeStore)
 			reader2.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader2.walkDisk(context.Background())
+			_, err = reader2.walkDisk(context.Background())
 			require.NoError(t, err)
 		})
 	})
@@ -461,8 +462,9 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			changes, err := reader.walkDisk(context.Background())
 			require.NoError(t, err)
+			assert.Equal(t, Changes{Updated: 1}, changes)
 		})
 
 		t.Run("Missing dashboard should be deleted if DisableDeletion = false", func(t *testing.T) {
@@ -476,8 +478,9 @@ func TestDashboardFileReader(t *testing.T) {
 			reader.dashboardProvisioningService = fakeService
 			require.NoError(t, err)
 
-			err = reader.walkDisk(context.Background())
+			changes, err := reader.walkDisk(context.Background())
 			require.NoError(t, err)
+			assert.Equal(t, Changes{Updated: 1, Deleted: 1}, changes)
 		})
 	})
 }
@@ -63,10 +63,10 @@ func TestDuplicatesValidator(t *testing.T) {
 
 		duplicateValidator := newDuplicateValidator(logger, []*FileReader{reader1, reader2})
 
-		err = reader1.walkDisk(context.Background())
+		_, err = reader1.walkDisk(context.Background())
 		require.NoError(t, err)
 
-		err = reader2.walkDisk(context.Background())
+		_, err = reader2.walkDisk(context.Background())
 		require.NoError(t, err)
 
 		duplicates := duplicateValidator.getDuplicates()
@@ -116,10 +116,10 @@ func TestDuplicatesValidator(t *testing.T) {
 
 		duplicateValidator := newDuplicateValidator(logger, []*FileReader{reader1, reader2})
 
-		err = reader1.walkDisk(context.Background())
+		_, err = reader1.walkDisk(context.Background())
 		require.NoError(t, err)
 
-		err = reader2.walkDisk(context.Background())
+		_, err = reader2.walkDisk(context.Background())
 		require.NoError(t, err)
 
 		duplicates := duplicateValidator.getDuplicates()
@@ -181,13 +181,13 @@ func TestDuplicatesValidator(t *testing.T) {
 
 		duplicateValidator := newDuplicateValidator(logger, []*FileReader{reader1, reader2, reader3})
 
-		err = reader1.walkDisk(context.Background())
+		_, err = reader1.walkDisk(context.Background())
 		require.NoError(t, err)
 
-		err = reader2.walkDisk(context.Background())
+		_, err = reader2.walkDisk(context.Background())
 		require.NoError(t, err)
 
-		err = reader3.walkDisk(context.Background())
+		_, err = reader3.walkDisk(context.Background())
 		require.NoError(t, err)
 
 		duplicates := duplicateValidator.getDuplicates()
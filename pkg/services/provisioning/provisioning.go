@@ -2,15 +2,20 @@ package provisioning
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"sync"
+	"time"
 
+	"github.com/grafana/grafana/pkg/bus"
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/infra/log"
 	plugifaces "github.com/grafana/grafana/pkg/plugins"
 	"github.com/grafana/grafana/pkg/registry"
 	"github.com/grafana/grafana/pkg/services/accesscontrol"
 	"github.com/grafana/grafana/pkg/services/alerting"
+	"github.com/grafana/grafana/pkg/services/contexthandler"
 	"github.com/grafana/grafana/pkg/services/correlations"
 	dashboardservice "github.com/grafana/grafana/pkg/services/dashboards"
 	datasourceservice "github.com/grafana/grafana/pkg/services/datasources"
@@ -51,10 +56,12 @@ func ProvideService(
 	quotaService quota.Service,
 	secrectService secrets.Service,
 	orgService org.Service,
+	bus bus.Bus,
 ) (*ProvisioningServiceImpl, error) {
 	s := &ProvisioningServiceImpl{
 		Cfg:                          cfg,
 		SQLStore:                     sqlStore,
+		bus:                          bus,
 		ac:                           ac,
 		pluginStore:                  pluginStore,
 		EncryptionService:            encryptionService,
@@ -92,9 +99,10 @@ type ProvisioningService interface {
 }
 
 // Add a public constructor for overriding service to be able to instantiate OSS as fallback
-func NewProvisioningServiceImpl() *ProvisioningServiceImpl {
+func NewProvisioningServiceImpl(bus bus.Bus) *ProvisioningServiceImpl {
 	logger := log.New("provisioning")
 	return &ProvisioningServiceImpl{
+		bus:                     bus,
 		log:                     logger,
 		newDashboardProvisioner: dashboards.New,
 		provisionNotifiers:      notifiers.Provision,
@@ -122,6 +130,7 @@ func newProvisioningServiceImpl(
 type ProvisioningServiceImpl struct {
 	Cfg                          *setting.Cfg
 	SQLStore                     *sqlstore.SQLStore
+	bus                          bus.Bus
 	orgService                   org.Service
 	ac                           accesscontrol.AccessControl
 	pluginStore                  plugifaces.Store
@@ -188,7 +197,7 @@ func (ps *ProvisioningServiceImpl) Run(ctx context.Context) error {
 		// non-deterministically take one of the route possibly going into one polling loop before exiting.
 		pollingContext, cancelFun := context.WithCancel(context.Background())
 		ps.pollingCtxCancel = cancelFun
-		ps.dashboardProvisioner.PollChanges(pollingContext)
+		ps.dashboardProvisioner.PollChanges(pollingContext, ps.publishDashboardChanges)
 		ps.mutex.Unlock()
 
 		select {
@@ -204,40 +213,53 @@ func (ps *ProvisioningServiceImpl) Run(ctx context.Context) error {
 }
 
 func (ps *ProvisioningServiceImpl) ProvisionDatasources(ctx context.Context) error {
+	start := time.Now()
 	datasourcePath := filepath.Join(ps.Cfg.ProvisioningPath, "datasources")
-	if err := ps.provisionDatasources(ctx, datasourcePath, ps.datasourceService, ps.correlationsService, ps.SQLStore); err != nil {
+	err := ps.provisionDatasources(ctx, datasourcePath, ps.datasourceService, ps.correlationsService, ps.SQLStore)
+	if err != nil {
 		err = fmt.Errorf("%v: %w", "Datasource provisioning error", err)
 		ps.log.Error("Failed to provision data sources", "error", err)
-		return err
 	}
-	return nil
+	ps.publishProvisioningReloaded(ctx, "datasources", start, err, nil)
+	return err
 }
 
 func (ps *ProvisioningServiceImpl) ProvisionPlugins(ctx context.Context) error {
+	start := time.Now()
 	appPath := filepath.Join(ps.Cfg.ProvisioningPath, "plugins")
-	if err := ps.provisionPlugins(ctx, appPath, ps.pluginStore, ps.pluginsSettings, ps.orgService); err != nil {
+	err := ps.provisionPlugins(ctx, appPath, ps.pluginStore, ps.pluginsSettings, ps.orgService)
+	if err != nil {
 		err = fmt.Errorf("%v: %w", "app provisioning error", err)
 		ps.log.Error("Failed to provision plugins", "error", err)
-		return err
 	}
-	return nil
+	ps.publishProvisioningReloaded(ctx, "plugins", start, err, nil)
+	return err
 }
 
 func (ps *ProvisioningServiceImpl) ProvisionNotifications(ctx context.Context) error {
+	start := time.Now()
 	alertNotificationsPath := filepath.Join(ps.Cfg.ProvisioningPath, "notifiers")
-	if err := ps.provisionNotifiers(ctx, alertNotificationsPath, ps.alertingService, ps.orgService, ps.SQLStore, ps.EncryptionService, ps.NotificationService); err != nil {
+	err := ps.provisionNotifiers(ctx, alertNotificationsPath, ps.alertingService, ps.orgService, ps.SQLStore, ps.EncryptionService, ps.NotificationService)
+	if err != nil {
 		err = fmt.Errorf("%v: %w", "Alert notification provisioning error", err)
 		ps.log.Error("Failed to provision alert notifications", "error", err)
-		return err
 	}
-	return nil
+	ps.publishProvisioningReloaded(ctx, "notifications", start, err, nil)
+	return err
 }
 
 func (ps *ProvisioningServiceImpl) ProvisionDashboards(ctx context.Context) error {
+	start := time.Now()
+	changes, err := ps.provisionDashboards(ctx)
+	ps.publishProvisioningReloaded(ctx, "dashboards", start, err, &changes)
+	return err
+}
+
+func (ps *ProvisioningServiceImpl) provisionDashboards(ctx context.Context) (dashboards.Changes, error) {
 	dashboardPath := filepath.Join(ps.Cfg.ProvisioningPath, "dashboards")
 	dashProvisioner, err := ps.newDashboardProvisioner(ctx, dashboardPath, ps.dashboardProvisioningService, ps.SQLStore, ps.dashboardService)
 	if err != nil {
-		return fmt.Errorf("%v: %w", "Failed to create provisioner", err)
+		return dashboards.Changes{}, fmt.Errorf("%v: %w", "Failed to create provisioner", err)
 	}
 
 	ps.mutex.Lock()
@@ -246,17 +268,18 @@ func (ps *ProvisioningServiceImpl) ProvisionDashboards(ctx context.Context) erro
 	ps.cancelPolling()
 	dashProvisioner.CleanUpOrphanedDashboards(ctx)
 
-	err = dashProvisioner.Provision(ctx)
+	changes, err := dashProvisioner.Provision(ctx)
 	if err != nil {
 		// If we fail to provision with the new provisioner, the mutex will unlock and the polling will restart with the
 		// old provisioner as we did not switch them yet.
-		return fmt.Errorf("%v: %w", "Failed to provision dashboards", err)
+		return changes, fmt.Errorf("%v: %w", "Failed to provision dashboards", err)
 	}
 	ps.dashboardProvisioner = dashProvisioner
-	return nil
+	return changes, nil
 }
 
 func (ps *ProvisioningServiceImpl) ProvisionAlerting(ctx context.Context) error {
+	start := time.Now()
 	alertingPath := filepath.Join(ps.Cfg.ProvisioningPath, "alerting")
 	st := store.DBstore{
 		Cfg:              ps.Cfg.UnifiedAlerting,
@@ -290,7 +313,9 @@ func (ps *ProvisioningServiceImpl) ProvisionAlerting(ctx context.Context) error
 		MuteTimingService:          *mutetimingsService,
 		TemplateService:            *templateService,
 	}
-	return ps.provisionAlerting(ctx, cfg)
+	err := ps.provisionAlerting(ctx, cfg)
+	ps.publishProvisioningReloaded(ctx, "alerting", start, err, nil)
+	return err
 }
 
 func (ps *ProvisioningServiceImpl) GetDashboardProvisionerResolvedPath(name string) string {
@@ -301,6 +326,43 @@ func (ps *ProvisioningServiceImpl) GetAllowUIUpdatesFromConfig(name string) bool
 	return ps.dashboardProvisioner.GetAllowUIUpdatesFromConfig(name)
 }
 
+// publishDashboardChanges publishes a ProvisioningReloaded event for dashboard changes picked up while polling.
+func (ps *ProvisioningServiceImpl) publishDashboardChanges(changes dashboards.Changes, start time.Time) {
+	ps.publishProvisioningReloaded(context.Background(), "dashboards", start, nil, &changes)
+}
+
+// publishProvisioningReloaded publishes a ProvisioningReloaded event once a provisioner has run,
+// whether it succeeded or not. The signed in user is taken from ctx if the run was requested through the API.
+func (ps *ProvisioningServiceImpl) publishProvisioningReloaded(ctx context.Context, provisioner string, start time.Time, reloadErr error, changes *dashboards.Changes) {
+	if ps.bus == nil {
+		return
+	}
+
+	evt := &events.ProvisioningReloaded{
+		Timestamp:   time.Now(),
+		Provisioner: provisioner,
+		DurationMs:  time.Since(start).Milliseconds(),
+	}
+	if reloadErr != nil && !errors.Is(reloadErr, context.Canceled) {
+		evt.Error = reloadErr.Error()
+	}
+	if changes != nil {
+		evt.Dashboards = &events.ProvisionedDashboards{
+			Created: changes.Created,
+			Updated: changes.Updated,
+			Deleted: changes.Deleted,
+		}
+	}
+	if c := contexthandler.FromContext(ctx); c != nil && c.SignedInUser != nil {
+		evt.UserID = c.UserID
+		evt.UserLogin = c.Login
+	}
+
+	if err := ps.bus.Publish(ctx, evt); err != nil {
+		ps.log.Error("Failed to publish provisioning reloaded event", "provisioner", provisioner, "error", err)
+	}
+}
+
 func (ps *ProvisioningServiceImpl) cancelPolling() {
 	if ps.pollingCtxCancel != nil {
 		ps.log.Debug("Stop polling for dashboard changes")
@@ -6,11 +6,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/grafana/grafana/pkg/bus"
+	"github.com/grafana/grafana/pkg/events"
+	"github.com/grafana/grafana/pkg/infra/tracing"
+	"github.com/grafana/grafana/pkg/models"
+	"github.com/grafana/grafana/pkg/services/contexthandler/ctxkey"
 	dashboardstore "github.com/grafana/grafana/pkg/services/dashboards"
 	"github.com/grafana/grafana/pkg/services/provisioning/dashboards"
 	"github.com/grafana/grafana/pkg/services/provisioning/utils"
+	"github.com/grafana/grafana/pkg/services/user"
 	"github.com/grafana/grafana/pkg/setting"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProvisioningServiceImpl(t *testing.T) {
@@ -49,8 +56,8 @@ func TestProvisioningServiceImpl(t *testing.T) {
 		serviceTest.waitForPollChanges()
 		assert.Equal(t, 1, len(serviceTest.mock.Calls.PollChanges), "PollChanges should have been called")
 
-		serviceTest.mock.ProvisionFunc = func(ctx context.Context) error {
-			return errors.New("Test error")
+		serviceTest.mock.ProvisionFunc = func(ctx context.Context) (dashboards.Changes, error) {
+			return dashboards.Changes{}, errors.New("Test error")
 		}
 		err = serviceTest.service.ProvisionDashboards(context.Background())
 		assert.NotNil(t, err)
@@ -63,6 +70,84 @@ func TestProvisioningServiceImpl(t *testing.T) {
 		// Cancelling the root context and stopping the service
 		serviceTest.cancel()
 	})
+
+	t.Run("Provisioning dashboards publishes ProvisioningReloaded with the dashboard changes", func(t *testing.T) {
+		serviceTest := setup()
+		serviceTest.mock.ProvisionFunc = func(ctx context.Context) (dashboards.Changes, error) {
+			return dashboards.Changes{Created: 2, Updated: 1, Deleted: 1}, nil
+		}
+
+		ctx := ctxkey.Set(context.Background(), &models.ReqContext{SignedInUser: &user.SignedInUser{UserID: 3, Login: "admin"}})
+		err := serviceTest.service.ProvisionDashboards(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, serviceTest.reloaded, 1)
+		evt := serviceTest.reloaded[0]
+		assert.Equal(t, "dashboards", evt.Provisioner)
+		assert.Empty(t, evt.Error)
+		assert.Equal(t, &events.ProvisionedDashboards{Created: 2, Updated: 1, Deleted: 1}, evt.Dashboards)
+		assert.Equal(t, int64(3), evt.UserID)
+		assert.Equal(t, "admin", evt.UserLogin)
+	})
+
+	t.Run("Failed dashboard provisioning publishes ProvisioningReloaded with the error", func(t *testing.T) {
+		serviceTest := setup()
+		serviceTest.mock.ProvisionFunc = func(ctx context.Context) (dashboards.Changes, error) {
+			return dashboards.Changes{}, errors.New("Test error")
+		}
+
+		err := serviceTest.service.ProvisionDashboards(context.Background())
+		require.Error(t, err)
+
+		require.Len(t, serviceTest.reloaded, 1)
+		assert.Equal(t, err.Error(), serviceTest.reloaded[0].Error)
+	})
+
+	t.Run("Dashboard changes picked up while polling publish ProvisioningReloaded", func(t *testing.T) {
+		serviceTest := setup()
+		serviceTest.mock.PollChangesFunc = func(ctx context.Context, onChanges dashboards.ChangesHandler) {
+			onChanges(dashboards.Changes{Updated: 1}, time.Now())
+			serviceTest.pollChangesChannel <- ctx
+		}
+		// Run provisions the dashboards before it starts polling
+		serviceTest.startService()
+		serviceTest.waitForPollChanges()
+
+		require.Len(t, serviceTest.reloaded, 2)
+		assert.Equal(t, "dashboards", serviceTest.reloaded[1].Provisioner)
+		assert.Equal(t, &events.ProvisionedDashboards{Updated: 1}, serviceTest.reloaded[1].Dashboards)
+
+		serviceTest.cancel()
+		serviceTest.waitForStop()
+	})
+}
+
+func TestNewProvisioningServiceImpl(t *testing.T) {
+	newService := func(t *testing.T, b bus.Bus) *ProvisioningServiceImpl {
+		service := NewProvisioningServiceImpl(b)
+		service.Cfg = setting.NewCfg()
+		service.Cfg.ProvisioningPath = t.TempDir()
+		return service
+	}
+
+	t.Run("Publishes ProvisioningReloaded on the bus it was built with", func(t *testing.T) {
+		b := bus.ProvideBus(tracing.InitializeTracerForTest())
+		var reloaded []*events.ProvisioningReloaded
+		b.AddEventListener(func(_ context.Context, evt *events.ProvisioningReloaded) error {
+			reloaded = append(reloaded, evt)
+			return nil
+		})
+
+		err := newService(t, b).ProvisionDatasources(context.Background())
+		require.NoError(t, err)
+		require.Len(t, reloaded, 1)
+		assert.Equal(t, "datasources", reloaded[0].Provisioner)
+	})
+
+	t.Run("Provisions without a bus", func(t *testing.T) {
+		err := newService(t, nil).ProvisionDatasources(context.Background())
+		require.NoError(t, err)
+	})
 }
 
 type serviceTestStruct struct {
@@ -76,6 +161,9 @@ type serviceTestStruct struct {
 	startService func()
 	cancel       func()
 
+	pollChangesChannel chan context.Context
+	reloaded           []*events.ProvisioningReloaded
+
 	mock    *dashboards.ProvisionerMock
 	service *ProvisioningServiceImpl
 }
@@ -85,10 +173,11 @@ func setup() *serviceTestStruct {
 	serviceTest.waitTimeout = time.Second
 
 	pollChangesChannel := make(chan context.Context)
+	serviceTest.pollChangesChannel = pollChangesChannel
 	serviceStopped := make(chan interface{})
 
 	serviceTest.mock = dashboards.NewDashboardProvisionerMock()
-	serviceTest.mock.PollChangesFunc = func(ctx context.Context) {
+	serviceTest.mock.PollChangesFunc = func(ctx context.Context, _ dashboards.ChangesHandler) {
 		pollChangesChannel <- ctx
 	}
 
@@ -101,6 +190,11 @@ func setup() *serviceTestStruct {
 		nil,
 	)
 	serviceTest.service.Cfg = setting.NewCfg()
+	serviceTest.service.bus = bus.ProvideBus(tracing.InitializeTracerForTest())
+	serviceTest.service.bus.AddEventListener(func(_ context.Context, evt *events.ProvisioningReloaded) error {
+		serviceTest.reloaded = append(serviceTest.reloaded, evt)
+		return nil
+	})
 
 	ctx, cancel := context.WithCancel(context.Background())
 	serviceTest.cancel = cancel
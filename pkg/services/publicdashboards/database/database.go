@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/models"
 	"github.com/grafana/grafana/pkg/services/dashboards"
@@ -175,6 +176,15 @@ func (d *PublicDashboardStoreImpl) SavePublicDashboardConfig(ctx context.Context
 			return err
 		}
 
+		sess.PublishAfterCommit(&events.PublicDashboardCreated{
+			Timestamp:    cmd.PublicDashboard.CreatedAt,
+			UID:          cmd.PublicDashboard.Uid,
+			DashboardUID: cmd.PublicDashboard.DashboardUid,
+			OrgID:        cmd.PublicDashboard.OrgId,
+			IsEnabled:    cmd.PublicDashboard.IsEnabled,
+			UserID:       cmd.PublicDashboard.CreatedBy,
+		})
+
 		return nil
 	})
 
@@ -189,7 +199,7 @@ func (d *PublicDashboardStoreImpl) UpdatePublicDashboardConfig(ctx context.Conte
 			return err
 		}
 
-		_, err = sess.Exec("UPDATE dashboard_public SET is_enabled = ?, time_settings = ?, updated_by = ?, updated_at = ? WHERE uid = ?",
+		res, err := sess.Exec("UPDATE dashboard_public SET is_enabled = ?, time_settings = ?, updated_by = ?, updated_at = ? WHERE uid = ?",
 			cmd.PublicDashboard.IsEnabled,
 			string(timeSettingsJSON),
 			cmd.PublicDashboard.UpdatedBy,
@@ -200,6 +210,23 @@ func (d *PublicDashboardStoreImpl) UpdatePublicDashboardConfig(ctx context.Conte
 			return err
 		}
 
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		sess.PublishAfterCommit(&events.PublicDashboardUpdated{
+			Timestamp:    cmd.PublicDashboard.UpdatedAt,
+			UID:          cmd.PublicDashboard.Uid,
+			DashboardUID: cmd.PublicDashboard.DashboardUid,
+			OrgID:        cmd.PublicDashboard.OrgId,
+			IsEnabled:    cmd.PublicDashboard.IsEnabled,
+			UserID:       cmd.PublicDashboard.UpdatedBy,
+		})
+
 		return nil
 	})
 
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/grafana/grafana/pkg/components/simplejson"
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/models"
 	"github.com/grafana/grafana/pkg/services/dashboards"
 	dashboardsDB "github.com/grafana/grafana/pkg/services/dashboards/database"
@@ -292,6 +293,36 @@ func TestIntegrationSavePublicDashboardConfig(t *testing.T) {
 		assert.False(t, pubdash2.IsEnabled)
 	})
 
+	t.Run("publishes PublicDashboardCreated", func(t *testing.T) {
+		setup()
+		var created []*events.PublicDashboardCreated
+		sqlStore.Bus().AddEventListener(func(_ context.Context, e *events.PublicDashboardCreated) error {
+			created = append(created, e)
+			return nil
+		})
+
+		err := publicdashboardStore.SavePublicDashboardConfig(context.Background(), SavePublicDashboardConfigCommand{
+			PublicDashboard: PublicDashboard{
+				IsEnabled:    true,
+				Uid:          "pubdash-created-uid",
+				DashboardUid: savedDashboard2.Uid,
+				OrgId:        savedDashboard2.OrgId,
+				TimeSettings: DefaultTimeSettings,
+				CreatedAt:    DefaultTime,
+				CreatedBy:    7,
+				AccessToken:  "NOTAREALUUID2",
+			},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, created, 1)
+		assert.Equal(t, "pubdash-created-uid", created[0].UID)
+		assert.Equal(t, savedDashboard2.Uid, created[0].DashboardUID)
+		assert.Equal(t, savedDashboard2.OrgId, created[0].OrgID)
+		assert.True(t, created[0].IsEnabled)
+		assert.Equal(t, int64(7), created[0].UserID)
+	})
+
 	t.Run("guards from saving without dashboardUid", func(t *testing.T) {
 		setup()
 		err := publicdashboardStore.SavePublicDashboardConfig(context.Background(), SavePublicDashboardConfigCommand{
@@ -387,6 +418,71 @@ func TestIntegrationUpdatePublicDashboard(t *testing.T) {
 		assert.NotEqual(t, updatedPublicDashboard.UpdatedAt, pdNotUpdatedRetrieved.UpdatedAt)
 		assert.NotEqual(t, updatedPublicDashboard.IsEnabled, pdNotUpdatedRetrieved.IsEnabled)
 	})
+
+	t.Run("publishes PublicDashboardUpdated", func(t *testing.T) {
+		setup()
+		var updated []*events.PublicDashboardUpdated
+		sqlStore.Bus().AddEventListener(func(_ context.Context, e *events.PublicDashboardUpdated) error {
+			updated = append(updated, e)
+			return nil
+		})
+
+		err := publicdashboardStore.SavePublicDashboardConfig(context.Background(), SavePublicDashboardConfigCommand{
+			PublicDashboard: PublicDashboard{
+				Uid:          "pubdash-updated-uid",
+				DashboardUid: savedDashboard.Uid,
+				OrgId:        savedDashboard.OrgId,
+				IsEnabled:    true,
+				CreatedAt:    DefaultTime,
+				CreatedBy:    7,
+				AccessToken:  "NOTAREALUUID",
+			},
+		})
+		require.NoError(t, err)
+
+		err = publicdashboardStore.UpdatePublicDashboardConfig(context.Background(), SavePublicDashboardConfigCommand{
+			PublicDashboard: PublicDashboard{
+				Uid:          "pubdash-updated-uid",
+				DashboardUid: savedDashboard.Uid,
+				OrgId:        savedDashboard.OrgId,
+				IsEnabled:    false,
+				TimeSettings: DefaultTimeSettings,
+				UpdatedAt:    DefaultTime,
+				UpdatedBy:    8,
+			},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, updated, 1)
+		assert.Equal(t, "pubdash-updated-uid", updated[0].UID)
+		assert.Equal(t, savedDashboard.Uid, updated[0].DashboardUID)
+		assert.Equal(t, savedDashboard.OrgId, updated[0].OrgID)
+		assert.False(t, updated[0].IsEnabled)
+		assert.Equal(t, int64(8), updated[0].UserID)
+	})
+
+	t.Run("does not publish PublicDashboardUpdated if no public dashboard was updated", func(t *testing.T) {
+		setup()
+		var updated []*events.PublicDashboardUpdated
+		sqlStore.Bus().AddEventListener(func(_ context.Context, e *events.PublicDashboardUpdated) error {
+			updated = append(updated, e)
+			return nil
+		})
+
+		err := publicdashboardStore.UpdatePublicDashboardConfig(context.Background(), SavePublicDashboardConfigCommand{
+			PublicDashboard: PublicDashboard{
+				Uid:          "pubdash-missing-uid",
+				DashboardUid: savedDashboard.Uid,
+				OrgId:        savedDashboard.OrgId,
+				TimeSettings: DefaultTimeSettings,
+				UpdatedAt:    DefaultTime,
+				UpdatedBy:    8,
+			},
+		})
+		require.NoError(t, err)
+
+		require.Empty(t, updated)
+	})
 }
 
 func insertTestDashboard(t *testing.T, dashboardStore *dashboardsDB.DashboardStore, title string, orgId int64,
@@ -122,7 +122,7 @@ func (pd *PublicDashboardServiceImpl) SavePublicDashboardConfig(ctx context.Cont
 		}
 		pubdashUid, err = pd.savePublicDashboardConfig(ctx, dto)
 	} else {
-		pubdashUid, err = pd.updatePublicDashboardConfig(ctx, dto)
+		pubdashUid, err = pd.updatePublicDashboardConfig(ctx, existingPubdash, dto)
 	}
 	if err != nil {
 		return nil, err
@@ -174,11 +174,14 @@ func (pd *PublicDashboardServiceImpl) savePublicDashboardConfig(ctx context.Cont
 }
 
 // Called by SavePublicDashboard this handles business logic for updating a
-// dashboard and calls update at the database layer
-func (pd *PublicDashboardServiceImpl) updatePublicDashboardConfig(ctx context.Context, dto *SavePublicDashboardConfigDTO) (string, error) {
+// dashboard and calls update at the database layer. The dashboard and org are
+// taken from the existing public dashboard, as the update is scoped by uid only.
+func (pd *PublicDashboardServiceImpl) updatePublicDashboardConfig(ctx context.Context, existingPubdash *PublicDashboard, dto *SavePublicDashboardConfigDTO) (string, error) {
 	cmd := SavePublicDashboardConfigCommand{
 		PublicDashboard: PublicDashboard{
-			Uid:          dto.PublicDashboard.Uid,
+			Uid:          existingPubdash.Uid,
+			DashboardUid: existingPubdash.DashboardUid,
+			OrgId:        existingPubdash.OrgId,
 			IsEnabled:    dto.PublicDashboard.IsEnabled,
 			TimeSettings: dto.PublicDashboard.TimeSettings,
 			UpdatedBy:    dto.UserId,
@@ -186,7 +189,7 @@ func (pd *PublicDashboardServiceImpl) updatePublicDashboardConfig(ctx context.Co
 		},
 	}
 
-	return dto.PublicDashboard.Uid, pd.store.UpdatePublicDashboardConfig(ctx, cmd)
+	return existingPubdash.Uid, pd.store.UpdatePublicDashboardConfig(ctx, cmd)
 }
 
 func (pd *PublicDashboardServiceImpl) GetQueryDataResponse(ctx context.Context, skipCache bool, queryDto PublicDashboardQueryDTO, panelId int64, accessToken string) (*backend.QueryDataResponse, error) {
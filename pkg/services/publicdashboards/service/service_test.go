@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/grafana/grafana/pkg/components/simplejson"
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/models"
 	dashboardsDB "github.com/grafana/grafana/pkg/services/dashboards/database"
@@ -243,9 +244,10 @@ func TestUpdatePublicDashboard(t *testing.T) {
 		savedPubdash, err := service.SavePublicDashboardConfig(context.Background(), SignedInUser, dto)
 		require.NoError(t, err)
 
-		// attempt to overwrite settings
+		// attempt to overwrite settings, and to move the public dashboard to another dashboard
+		otherDashboard := insertTestDashboard(t, dashboardStore, "otherDashie", 1, 0, true, []map[string]interface{}{})
 		dto = &SavePublicDashboardConfigDTO{
-			DashboardUid: dashboard.Uid,
+			DashboardUid: otherDashboard.Uid,
 			OrgId:        dashboard.OrgId,
 			UserId:       8,
 			PublicDashboard: &PublicDashboard{
@@ -261,11 +263,24 @@ func TestUpdatePublicDashboard(t *testing.T) {
 			},
 		}
 
+		var updated []*events.PublicDashboardUpdated
+		sqlStore.Bus().AddEventListener(func(_ context.Context, e *events.PublicDashboardUpdated) error {
+			updated = append(updated, e)
+			return nil
+		})
+
 		// Since the dto.PublicDashboard has a uid, this will call
 		// service.updatePublicDashboardConfig
 		updatedPubdash, err := service.SavePublicDashboardConfig(context.Background(), SignedInUser, dto)
 		require.NoError(t, err)
 
+		// the event is published for the dashboard the public dashboard belongs to,
+		// not the ones in the dto or the payload
+		require.Len(t, updated, 1)
+		assert.Equal(t, savedPubdash.DashboardUid, updated[0].DashboardUID)
+		assert.Equal(t, savedPubdash.OrgId, updated[0].OrgID)
+		assert.Equal(t, dto.UserId, updated[0].UserID)
+
 		// don't get updated
 		assert.Equal(t, savedPubdash.DashboardUid, updatedPubdash.DashboardUid)
 		assert.Equal(t, savedPubdash.OrgId, updatedPubdash.OrgId)
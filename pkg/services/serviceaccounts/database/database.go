@@ -180,6 +180,9 @@ func (s *ServiceAccountsStoreImpl) deleteServiceAccount(sess *sqlstore.DBSession
 	if !has {
 		return serviceaccounts.ErrServiceAccountNotFound
 	}
+	if err := sqlstore.PublishTeamMembersRemoved(sess, 0, 0, user.ID); err != nil {
+		return err
+	}
 	for _, sql := range ServiceAccountDeletions() {
 		_, err := sess.Exec(sql, user.ID)
 		if err != nil {
@@ -0,0 +1,66 @@
+package sqlstore
+
+import (
+	"time"
+
+	"github.com/grafana/grafana/pkg/events"
+	"github.com/grafana/grafana/pkg/models"
+)
+
+// PublishDashboardACLsRemoved queues a DashboardACLUpdated event for every dashboard with ACL entries in the
+// given org, dashboard, team and user, a zero id matches any. At least one id has to be set.
+// Each event carries the entries the dashboard is left with once the matching entries are removed.
+// It has to be called within the transaction, before the matching dashboard_acl rows are deleted.
+func PublishDashboardACLsRemoved(sess *DBSession, orgID, dashboardID, teamID, userID int64) error {
+	cond, args, err := idCondition([]string{"org_id", "dashboard_id", "team_id", "user_id"}, orgID, dashboardID, teamID, userID)
+	if err != nil {
+		return err
+	}
+
+	var acl []*models.DashboardACL
+	if err := sess.SQL("SELECT * FROM dashboard_acl WHERE dashboard_id IN (SELECT dashboard_id FROM dashboard_acl WHERE "+cond+")", args...).Find(&acl); err != nil {
+		return err
+	}
+
+	matches := func(item *models.DashboardACL) bool {
+		return (orgID == 0 || item.OrgID == orgID) &&
+			(dashboardID == 0 || item.DashboardID == dashboardID) &&
+			(teamID == 0 || item.TeamID == teamID) &&
+			(userID == 0 || item.UserID == userID)
+	}
+
+	now := time.Now()
+	updated := make(map[int64]*events.DashboardACLUpdated)
+	order := make([]int64, 0)
+	for _, item := range acl {
+		evt, ok := updated[item.DashboardID]
+		if !ok {
+			evt = &events.DashboardACLUpdated{
+				Timestamp:   now,
+				DashboardID: item.DashboardID,
+				OrgID:       item.OrgID,
+				Items:       make([]events.DashboardACLItem, 0),
+			}
+			updated[item.DashboardID] = evt
+			order = append(order, item.DashboardID)
+		}
+		if matches(item) {
+			continue
+		}
+		aclItem := events.DashboardACLItem{
+			UserID:     item.UserID,
+			TeamID:     item.TeamID,
+			Permission: int(item.Permission),
+		}
+		if item.Role != nil {
+			aclItem.Role = string(*item.Role)
+		}
+		evt.Items = append(evt.Items, aclItem)
+	}
+
+	for _, id := range order {
+		sess.PublishAfterCommit(updated[id])
+	}
+
+	return nil
+}
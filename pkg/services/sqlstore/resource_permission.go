@@ -0,0 +1,51 @@
+package sqlstore
+
+import (
+	"time"
+
+	"github.com/grafana/grafana/pkg/events"
+	ac "github.com/grafana/grafana/pkg/services/accesscontrol"
+)
+
+// PublishResourcePermissionsRemoved queues a ResourcePermissionUpdated event without a permission for every
+// user, team and built-in role that has managed permissions with scope on the resource.
+// It has to be called within the transaction, before the matching permission rows are deleted.
+func PublishResourcePermissionsRemoved(sess *DBSession, resource, resourceID, scope string) error {
+	var assignments []struct {
+		OrgID       int64  `xorm:"org_id"`
+		UserID      int64  `xorm:"user_id"`
+		TeamID      int64  `xorm:"team_id"`
+		BuiltInRole string `xorm:"built_in_role"`
+	}
+	rawSQL := `
+		SELECT DISTINCT
+			r.org_id AS org_id,
+			COALESCE(ur.user_id, 0) AS user_id,
+			COALESCE(tr.team_id, 0) AS team_id,
+			COALESCE(br.role, '') AS built_in_role
+		FROM permission p
+			INNER JOIN role r ON r.id = p.role_id
+			LEFT JOIN user_role ur ON ur.role_id = r.id
+			LEFT JOIN team_role tr ON tr.role_id = r.id
+			LEFT JOIN builtin_role br ON br.role_id = r.id
+		WHERE p.scope = ? AND r.name LIKE ?`
+	if err := sess.SQL(rawSQL, scope, ac.ManagedRolePrefix+"%").Find(&assignments); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, a := range assignments {
+		sess.PublishAfterCommit(&events.ResourcePermissionUpdated{
+			Timestamp:   now,
+			OrgID:       a.OrgID,
+			Resource:    resource,
+			ResourceID:  resourceID,
+			UserID:      a.UserID,
+			TeamID:      a.TeamID,
+			BuiltInRole: a.BuiltInRole,
+			Actions:     []string{},
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package sqlstore
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/grafana/grafana/pkg/events"
+	"github.com/grafana/grafana/pkg/models"
+)
+
+// PublishTeamMembersRemoved queues a TeamMemberRemoved event for every team member in the given org and team
+// that belongs to the given user, a zero id matches any. At least one id has to be set.
+// It has to be called within the transaction, before the matching team_member rows are deleted.
+func PublishTeamMembersRemoved(sess *DBSession, orgID, teamID, userID int64) error {
+	cond, args, err := idCondition([]string{"org_id", "team_id", "user_id"}, orgID, teamID, userID)
+	if err != nil {
+		return err
+	}
+
+	var members []models.TeamMember
+	if err := sess.SQL("SELECT org_id, team_id, user_id FROM team_member WHERE "+cond, args...).Find(&members); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, m := range members {
+		sess.PublishAfterCommit(&events.TeamMemberRemoved{
+			Timestamp: now,
+			TeamID:    m.TeamId,
+			UserID:    m.UserId,
+			OrgID:     m.OrgId,
+		})
+	}
+
+	return nil
+}
+
+var errNoIDFilter = errors.New("at least one id has to be set")
+
+// idCondition builds a where condition matching the non-zero ids of the given columns,
+// e.g. idCondition([]string{"org_id", "user_id"}, 1, 0) matches "org_id = ?" with 1.
+func idCondition(columns []string, ids ...int64) (string, []interface{}, error) {
+	conds := make([]string, 0, len(columns))
+	args := make([]interface{}, 0, len(columns))
+	for i, column := range columns {
+		if ids[i] == 0 {
+			continue
+		}
+		conds = append(conds, column+" = ?")
+		args = append(args, ids[i])
+	}
+	if len(conds) == 0 {
+		return "", nil, errNoIDFilter
+	}
+	return strings.Join(conds, " AND "), args, nil
+}
@@ -0,0 +1,21 @@
+package sqlstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIDCondition(t *testing.T) {
+	t.Run("matches only the ids that are set", func(t *testing.T) {
+		cond, args, err := idCondition([]string{"org_id", "team_id", "user_id"}, 1, 0, 3)
+		require.NoError(t, err)
+		require.Equal(t, "org_id = ? AND user_id = ?", cond)
+		require.Equal(t, []interface{}{int64(1), int64(3)}, args)
+	})
+
+	t.Run("refuses to match every row", func(t *testing.T) {
+		_, _, err := idCondition([]string{"org_id", "team_id", "user_id"}, 0, 0, 0)
+		require.ErrorIs(t, err, errNoIDFilter)
+	})
+}
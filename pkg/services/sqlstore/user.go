@@ -483,6 +483,12 @@ func deleteUserInTransaction(ss *SQLStore, sess *DBSession, cmd *models.DeleteUs
 	if !has {
 		return user.ErrUserNotFound
 	}
+	if err := PublishTeamMembersRemoved(sess, 0, 0, cmd.UserId); err != nil {
+		return err
+	}
+	if err := PublishDashboardACLsRemoved(sess, 0, 0, 0, cmd.UserId); err != nil {
+		return err
+	}
 	for _, sql := range UserDeletions() {
 		_, err := sess.Exec(sql, cmd.UserId)
 		if err != nil {
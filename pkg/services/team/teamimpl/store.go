@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/models"
 	ac "github.com/grafana/grafana/pkg/services/accesscontrol"
 	"github.com/grafana/grafana/pkg/services/sqlstore"
@@ -144,6 +145,19 @@ func (ss *xormStore) Delete(ctx context.Context, cmd *models.DeleteTeamCommand)
 			return err
 		}
 
+		if err := sqlstore.PublishTeamMembersRemoved(sess, cmd.OrgId, cmd.Id, 0); err != nil {
+			return err
+		}
+
+		if err := sqlstore.PublishDashboardACLsRemoved(sess, cmd.OrgId, 0, cmd.Id, 0); err != nil {
+			return err
+		}
+
+		teamScope := ac.Scope("teams", "id", fmt.Sprint(cmd.Id))
+		if err := sqlstore.PublishResourcePermissionsRemoved(sess, "teams", fmt.Sprint(cmd.Id), teamScope); err != nil {
+			return err
+		}
+
 		deletes := []string{
 			"DELETE FROM team_member WHERE org_id=? and team_id = ?",
 			"DELETE FROM team WHERE org_id=? and id = ?",
@@ -158,7 +172,7 @@ func (ss *xormStore) Delete(ctx context.Context, cmd *models.DeleteTeamCommand)
 			}
 		}
 
-		_, err := sess.Exec("DELETE FROM permission WHERE scope=?", ac.Scope("teams", "id", fmt.Sprint(cmd.Id)))
+		_, err := sess.Exec("DELETE FROM permission WHERE scope=?", teamScope)
 
 		return err
 	})
@@ -434,8 +448,20 @@ func addTeamMember(sess *sqlstore.DBSession, orgID, teamID, userID int64, isExte
 		Permission: permission,
 	}
 
-	_, err := sess.Insert(&entity)
-	return err
+	if _, err := sess.Insert(&entity); err != nil {
+		return err
+	}
+
+	sess.PublishAfterCommit(&events.TeamMemberAdded{
+		Timestamp:  entity.Created,
+		TeamID:     teamID,
+		UserID:     userID,
+		OrgID:      orgID,
+		External:   isExternal,
+		Permission: int(permission),
+	})
+
+	return nil
 }
 
 func updateTeamMember(sess *sqlstore.DBSession, orgID, teamID, userID int64, permission models.PermissionType) error {
@@ -449,8 +475,19 @@ func updateTeamMember(sess *sqlstore.DBSession, orgID, teamID, userID int64, per
 	}
 
 	member.Permission = permission
-	_, err = sess.Cols("permission").Where("org_id=? and team_id=? and user_id=?", orgID, teamID, userID).Update(member)
-	return err
+	if _, err := sess.Cols("permission").Where("org_id=? and team_id=? and user_id=?", orgID, teamID, userID).Update(member); err != nil {
+		return err
+	}
+
+	sess.PublishAfterCommit(&events.TeamMemberUpdated{
+		Timestamp:  time.Now(),
+		TeamID:     teamID,
+		UserID:     userID,
+		OrgID:      orgID,
+		Permission: int(permission),
+	})
+
+	return nil
 }
 
 // RemoveTeamMember removes a member from a team
@@ -477,11 +514,21 @@ func removeTeamMember(sess *sqlstore.DBSession, cmd *models.RemoveTeamMemberComm
 		return err
 	}
 	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
 	if rows == 0 {
 		return models.ErrTeamMemberNotFound
 	}
 
-	return err
+	sess.PublishAfterCommit(&events.TeamMemberRemoved{
+		Timestamp: time.Now(),
+		TeamID:    cmd.TeamId,
+		UserID:    cmd.UserId,
+		OrgID:     cmd.OrgId,
+	})
+
+	return nil
 }
 
 // GetUserTeamMemberships return a list of memberships to teams granted to a user
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/grafana/grafana/pkg/events"
 	"github.com/grafana/grafana/pkg/models"
 	ac "github.com/grafana/grafana/pkg/services/accesscontrol"
 	"github.com/grafana/grafana/pkg/services/serviceaccounts"
@@ -713,3 +714,92 @@ func getDashboardACLInfoList(s *sqlstore.SQLStore, query *models.GetDashboardACL
 
 	return nil
 }
+
+func TestIntegrationTeamMemberEvents(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	sqlStore := sqlstore.InitTestDB(t)
+	teamSvc := ProvideService(sqlStore, sqlStore.Cfg)
+
+	var added []*events.TeamMemberAdded
+	var updated []*events.TeamMemberUpdated
+	var removed []*events.TeamMemberRemoved
+	sqlStore.Bus().AddEventListener(func(ctx context.Context, e *events.TeamMemberAdded) error {
+		added = append(added, e)
+		return nil
+	})
+	sqlStore.Bus().AddEventListener(func(ctx context.Context, e *events.TeamMemberUpdated) error {
+		updated = append(updated, e)
+		return nil
+	})
+	sqlStore.Bus().AddEventListener(func(ctx context.Context, e *events.TeamMemberRemoved) error {
+		removed = append(removed, e)
+		return nil
+	})
+
+	usr, err := sqlStore.CreateUser(context.Background(), user.CreateUserCommand{Login: "eventuser", Email: "eventuser@test.com"})
+	require.NoError(t, err)
+	team, err := teamSvc.CreateTeam("events team", "events@test.com", 1)
+	require.NoError(t, err)
+
+	err = teamSvc.AddTeamMember(usr.ID, 1, team.Id, true, 0)
+	require.NoError(t, err)
+	require.Len(t, added, 1)
+	assert.Equal(t, team.Id, added[0].TeamID)
+	assert.Equal(t, usr.ID, added[0].UserID)
+	assert.True(t, added[0].External)
+
+	err = teamSvc.UpdateTeamMember(context.Background(), &models.UpdateTeamMemberCommand{
+		UserId: usr.ID, OrgId: 1, TeamId: team.Id, Permission: models.PERMISSION_ADMIN,
+	})
+	require.NoError(t, err)
+	require.Len(t, updated, 1)
+	assert.Equal(t, int(models.PERMISSION_ADMIN), updated[0].Permission)
+
+	err = teamSvc.AddTeamMember(usr.ID, 1, team.Id, true, 0)
+	require.ErrorIs(t, err, models.ErrTeamMemberAlreadyAdded)
+	require.Len(t, added, 1)
+
+	err = teamSvc.RemoveTeamMember(context.Background(), &models.RemoveTeamMemberCommand{OrgId: 1, TeamId: team.Id, UserId: usr.ID})
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	assert.Equal(t, usr.ID, removed[0].UserID)
+
+	t.Run("deleting a team publishes removal of its members", func(t *testing.T) {
+		err := teamSvc.AddTeamMember(usr.ID, 1, team.Id, false, 0)
+		require.NoError(t, err)
+
+		err = teamSvc.DeleteTeam(context.Background(), &models.DeleteTeamCommand{OrgId: 1, Id: team.Id})
+		require.NoError(t, err)
+		require.Len(t, removed, 2)
+		assert.Equal(t, team.Id, removed[1].TeamID)
+		assert.Equal(t, usr.ID, removed[1].UserID)
+	})
+
+	t.Run("deleting a team publishes removal of its dashboard permissions", func(t *testing.T) {
+		team, err := teamSvc.CreateTeam("acl team", "acl@test.com", 1)
+		require.NoError(t, err)
+		err = sqlStore.WithDbSession(context.Background(), func(sess *sqlstore.DBSession) error {
+			_, err := sess.Insert(&models.DashboardACL{
+				OrgID: 1, DashboardID: 1, TeamID: team.Id, Permission: models.PERMISSION_VIEW,
+				Created: time.Now(), Updated: time.Now(),
+			})
+			return err
+		})
+		require.NoError(t, err)
+
+		var aclUpdated []*events.DashboardACLUpdated
+		sqlStore.Bus().AddEventListener(func(ctx context.Context, e *events.DashboardACLUpdated) error {
+			aclUpdated = append(aclUpdated, e)
+			return nil
+		})
+
+		err = teamSvc.DeleteTeam(context.Background(), &models.DeleteTeamCommand{OrgId: 1, Id: team.Id})
+		require.NoError(t, err)
+		require.Len(t, aclUpdated, 1)
+		assert.Equal(t, int64(1), aclUpdated[0].DashboardID)
+		assert.Equal(t, int64(1), aclUpdated[0].OrgID)
+		assert.Empty(t, aclUpdated[0].Items)
+	})
+}
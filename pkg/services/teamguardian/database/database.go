@@ -27,6 +27,10 @@ func (t *TeamGuardianStoreImpl) GetTeamMembers(ctx context.Context, query models
 
 func (t *TeamGuardianStoreImpl) DeleteByUser(ctx context.Context, userID int64) error {
 	return t.sqlStore.WithTransactionalDbSession(ctx, func(sess *sqlstore.DBSession) error {
+		if err := sqlstore.PublishTeamMembersRemoved(sess, 0, 0, userID); err != nil {
+			return err
+		}
+
 		var rawSQL = "DELETE FROM team_member WHERE user_id = ?"
 		_, err := sess.Exec(rawSQL, userID)
 		return err
@@ -285,6 +285,8 @@ type Cfg struct {
 	MetricsEndpointBasicAuthPassword string
 	MetricsEndpointDisableTotalStats bool
 	MetricsGrafanaEnvironmentInfo    map[string]string
+	// LoginStatsCollectionInterval is how often the duplicate and mixed cased user metrics are refreshed.
+	LoginStatsCollectionInterval time.Duration
 
 	// Dashboards
 	DefaultHomeDashboardPath string
@@ -983,6 +985,7 @@ func (cfg *Cfg) Load(args CommandLineArgs) error {
 	cfg.MetricsEndpointBasicAuthUsername = valueAsString(iniFile.Section("metrics"), "basic_auth_username", "")
 	cfg.MetricsEndpointBasicAuthPassword = valueAsString(iniFile.Section("metrics"), "basic_auth_password", "")
 	cfg.MetricsEndpointDisableTotalStats = iniFile.Section("metrics").Key("disable_total_stats").MustBool(false)
+	cfg.LoginStatsCollectionInterval = iniFile.Section("metrics").Key("login_stats_collection_interval").MustDuration(time.Hour * 4)
 
 	analytics := iniFile.Section("analytics")
 	cfg.CheckForGrafanaUpdates = analytics.Key("check_for_updates").MustBool(true)